@@ -1,22 +1,39 @@
 package main
 
 import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
-	grpc_validator "github.com/grpc-ecosystem/go-grpc-middleware/validator"
 
 	"compile-and-run-sandbox/internal/api/consumer"
+	"compile-and-run-sandbox/internal/auth"
+	"compile-and-run-sandbox/internal/certificates"
 	v1 "compile-and-run-sandbox/internal/gen/pb/content/consumer/v1"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 
 	"compile-and-run-sandbox/internal/files"
+	"compile-and-run-sandbox/internal/health"
 	"compile-and-run-sandbox/internal/parser"
+	"compile-and-run-sandbox/internal/ratelimit"
 	"compile-and-run-sandbox/internal/sandbox"
+	"compile-and-run-sandbox/internal/source"
+	"compile-and-run-sandbox/internal/validation"
 
 	"github.com/go-playground/locales/en"
 	ut "github.com/go-playground/universal-translator"
@@ -29,6 +46,17 @@ import (
 	"compile-and-run-sandbox/internal/repository"
 )
 
+// healthCheckInterval is the interval in which the readiness checks are run
+// to update the serving status of the health service.
+const healthCheckInterval = time.Second * 10
+
+// healthCheckTimeout is the maximum time the readiness checks can take.
+const healthCheckTimeout = time.Second * 5
+
+// certificateReloadInterval is the interval in which the certificate files are
+// checked for changes.
+const certificateReloadInterval = time.Second * 30
+
 func getTranslator() ut.Translator {
 	english := en.New()
 	uni := ut.New(english, english)
@@ -37,12 +65,94 @@ func getTranslator() ut.Translator {
 	return translator
 }
 
+// startGateway serves every consumer service method as HTTP/JSON on the given
+// address. Requests are proxied to the gRPC server to ensure the validation
+// and the interceptors are applied the same as calling the gRPC server. If the
+// reloader is provided the gateway is served and connects over tls, forwarding
+// the client certificate of each request.
+func startGateway(ctx context.Context, address string, grpcAddress string, reloader *certificates.Reloader) error {
+	mux := runtime.NewServeMux(
+		runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+			// expose the retry after header directly, allowing http clients to
+			// back off the same as gRPC clients when a limit is reached.
+			if key == ratelimit.RetryAfterHeader {
+				return "Retry-After", true
+			}
+
+			return runtime.MetadataHeaderPrefix + key, true
+		}),
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			// the forwarded client certificate is only ever set by the gateway,
+			// never passed through from the http client.
+			name, ok := runtime.DefaultHeaderMatcher(key)
+
+			if strings.EqualFold(name, auth.ForwardedClientCertKey) {
+				return "", false
+			}
+
+			return name, ok
+		}),
+		runtime.WithMetadata(func(_ context.Context, r *http.Request) metadata.MD {
+			if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+				return nil
+			}
+
+			der := r.TLS.VerifiedChains[0][0].Raw
+			return metadata.Pairs(auth.ForwardedClientCertKey, base64.StdEncoding.EncodeToString(der))
+		}),
+	)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	if reloader != nil {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(reloader.LoopbackTLSConfig()))}
+	}
+
+	if err := v1.RegisterConsumerServiceHandlerFromEndpoint(ctx, mux, grpcAddress, opts); err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: time.Second * 10,
+	}
+
+	log.Info().Msgf("http gateway listening on %s", address)
+
+	if reloader != nil {
+		server.TLSConfig = reloader.ServerTLSConfig()
+		return server.ListenAndServeTLS("", "")
+	}
+
+	return server.ListenAndServe()
+}
+
+// loopbackAddress returns the address used to connect to the server listening
+// on the given address from the same machine.
+func loopbackAddress(listenAddress string) string {
+	_, port, err := net.SplitHostPort(listenAddress)
+
+	if err != nil {
+		return listenAddress
+	}
+
+	return net.JoinHostPort("localhost", port)
+}
+
 func main() {
 	log.Info().Msg("starting cars-api")
 	args := parser.ParseDefaultConfigurationArguments()
 
 	sandbox.LoadEmbeddedTemplateFiles()
 
+	// templates within the directory are loaded on top of the embedded
+	// templates, allowing new templates without a new release.
+	if args.TemplatesDirectory != "" {
+		if err := sandbox.LoadTemplateDirectory(args.TemplatesDirectory); err != nil {
+			log.Fatal().Err(err).Msg("failed to load templates directory")
+		}
+	}
+
 	queueRunner, err := queue.NewQueue(&queue.Config{
 		ForceLocalMode: true,
 
@@ -95,27 +205,116 @@ func main() {
 	// error messages in the future.
 	_ = enTranslations.RegisterDefaultTranslations(validate, translator)
 
-	lis, err := net.Listen("tcp", ":8080")
+	lis, err := net.Listen("tcp", args.ListenAddress)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to listen")
 	}
 
-	server := grpc.NewServer(
+	serverOpts := []grpc.ServerOption{}
+
+	tlsConfig := &certificates.Config{
+		CertFile:        args.TLSCertFile,
+		KeyFile:         args.TLSKeyFile,
+		ClientCAFile:    args.TLSClientCAFile,
+		GatewayCertFile: args.TLSGatewayCertFile,
+		GatewayKeyFile:  args.TLSGatewayKeyFile,
+	}
+
+	var reloader *certificates.Reloader
+
+	// the certificates are reloaded when changed on disk, allowing them to be
+	// rotated without restarting the server.
+	if tlsConfig.Enabled() {
+		reloader, err = certificates.NewReloader(tlsConfig)
+
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load tls certificates")
+		}
+
+		go reloader.Watch(context.Background(), certificateReloadInterval)
+
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(reloader.ServerTLSConfig())))
+		log.Info().Bool("mtls", tlsConfig.ClientCAFile != "").Msg("tls enabled")
+	}
+
+	// every method other than ping requires a valid api key, which determines
+	// the tenant the executions are created for and can be read by.
+	authenticator := auth.NewAuthenticator(repo,
+		v1.ConsumerService_Ping_FullMethodName,
+		healthpb.Health_Check_FullMethodName,
+		healthpb.Health_Watch_FullMethodName,
+	)
+
+	// the limits of the tenant are used when the tenant has not been given
+	// its own limits within the repository.
+	limiter := ratelimit.NewLimiter(repo, &ratelimit.Limits{
+		RequestsPerSecond:     args.RateLimitRequestsPerSecond,
+		RequestBurst:          args.RateLimitBurst,
+		MaxInFlightExecutions: args.MaxInFlightExecutions,
+	})
+
+	// the client identity forwarded by the gateway is only trusted from a
+	// connection made with the gateway certificate.
+	identityForwarder := auth.NewIdentityForwarder(func(certificate *x509.Certificate) bool {
+		return reloader != nil && reloader.IsGatewayCertificate(certificate)
+	})
+
+	// recovery is the outermost interceptor, so a panic within any of the
+	// other interceptors is also returned as an internal error.
+	serverOpts = append(serverOpts,
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			grpc_validator.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(),
+			identityForwarder.UnaryServerInterceptor(),
+			authenticator.UnaryServerInterceptor(),
+			limiter.UnaryServerInterceptor(),
+			validation.UnaryServerInterceptor(translator),
+		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			grpc_recovery.StreamServerInterceptor(),
+			identityForwarder.StreamServerInterceptor(),
+			authenticator.StreamServerInterceptor(),
+			limiter.StreamServerInterceptor(),
+			validation.StreamServerInterceptor(translator),
 		)),
 	)
 
+	server := grpc.NewServer(serverOpts...)
+
 	v1.RegisterConsumerServiceServer(server, &consumer.Server{
 		FileHandler: fileHandler,
 		Repo:        repo,
 		Translator:  translator,
 		Validator:   validate,
 		Queue:       queueRunner,
+		Limiter:     limiter,
+		SourceLimits: source.Limits{
+			MaxBytes: args.SourceUploadMaxBytes,
+			MaxFiles: args.SourceUploadMaxFiles,
+		},
+		AllowPrivateCallbacks: args.WebhookAllowPrivateNetworks,
 	})
 
-	log.Info().Msg("listening on :8080")
+	// the serving status is updated periodically from the readiness checks of
+	// every dependency required to accept compile requests.
+	checker := health.NewChecker(healthCheckTimeout)
+	checker.Register("repository", func(context.Context) error { return repo.Ping() })
+	checker.Register("queue", func(context.Context) error { return queueRunner.Ping() })
+	checker.Register("files", func(context.Context) error { return fileHandler.Ping() })
+
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	go checker.Watch(context.Background(), healthServer, v1.ConsumerService_ServiceDesc.ServiceName, healthCheckInterval)
+
+	go func() {
+		gatewayErr := startGateway(context.Background(), args.GatewayAddress, loopbackAddress(args.ListenAddress), reloader)
+
+		if gatewayErr != nil {
+			log.Fatal().Err(gatewayErr).Msg("failed to start http gateway")
+		}
+	}()
+
+	log.Info().Msgf("listening on %s", args.ListenAddress)
 	if listenErr := server.Serve(lis); listenErr != nil {
 		log.Fatal().Err(listenErr).Msg("failed to listen")
 	}
@@ -2,10 +2,12 @@ package main
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"compile-and-run-sandbox/internal/parser"
 	"compile-and-run-sandbox/internal/repository"
@@ -14,10 +16,70 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"compile-and-run-sandbox/internal/files"
+	"compile-and-run-sandbox/internal/health"
 	"compile-and-run-sandbox/internal/queue"
 	"compile-and-run-sandbox/internal/sandbox"
+	"compile-and-run-sandbox/internal/webhook"
 )
 
+// healthCheckTimeout is the maximum time the readiness checks can take.
+const healthCheckTimeout = time.Second * 5
+
+// webhookShutdownTimeout is the maximum time waited for the webhooks still
+// being delivered when shutting down, any remaining retries are abandoned.
+const webhookShutdownTimeout = time.Second * 30
+
+// languageStatusInterval is the interval in which the availability of every
+// language is checked and published.
+const languageStatusInterval = time.Minute
+
+// publishLanguageStatuses publishes the version and availability of every
+// language until the context is done. The version of the toolchain is only
+// probed once per language, since the images do not change while running.
+func publishLanguageStatuses(ctx context.Context, manager *sandbox.ContainerManager, repo repository.Repository) {
+	versions := map[string]string{}
+
+	publish := func() {
+		for language, compiler := range sandbox.Compilers {
+			err := manager.CheckLanguageImage(ctx, compiler)
+
+			if err == nil && versions[language] == "" {
+				versions[language], err = manager.ProbeVersion(ctx, compiler)
+			}
+
+			languageStatus := &repository.LanguageStatus{
+				Language:  language,
+				Version:   versions[language],
+				Available: err == nil,
+				CheckedAt: time.Now(),
+			}
+
+			if err != nil {
+				log.Warn().Err(err).Str("language", language).Msg("language is unavailable")
+				languageStatus.Error = err.Error()
+			}
+
+			if err := repo.UpsertLanguageStatus(languageStatus); err != nil {
+				log.Error().Err(err).Str("language", language).Msg("failed to publish language status")
+			}
+		}
+	}
+
+	publish()
+
+	ticker := time.NewTicker(languageStatusInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			publish()
+		}
+	}
+}
+
 func main() {
 	log.Info().Msg("starting cars-loader")
 	args := parser.ParseDefaultConfigurationArguments()
@@ -47,6 +109,23 @@ func main() {
 		log.Fatal().Err(err).Msg("failed to create file handler")
 	}
 
+	if args.WebhookSecret == "" {
+		log.Warn().Msg("no webhook secret is configured, completion webhooks cannot be verified by receivers")
+	}
+
+	// the webhooks are delivered in the background until shutting down, when
+	// the remaining deliveries are given time to complete.
+	webhookCtx, cancelWebhooks := context.WithCancel(context.Background())
+	defer cancelWebhooks()
+
+	webhooks := webhook.NewSender(webhookCtx, &webhook.Config{
+		Secret:               args.WebhookSecret,
+		MaxAttempts:          args.WebhookMaxAttempts,
+		InitialBackoff:       args.WebhookInitialBackoff,
+		Timeout:              args.WebhookTimeout,
+		AllowPrivateNetworks: args.WebhookAllowPrivateNetworks,
+	}, webhook.NewRepositoryRecorder(repo))
+
 	log.Info().Msg("starting Queue")
 	queueRunner, err := queue.NewQueue(&queue.Config{
 		ForceLocalMode: true,
@@ -62,6 +141,7 @@ func main() {
 			Manager:          manager,
 			Repo:             repo,
 			FilesHandler:     localFileHandler,
+			Webhooks:         webhooks,
 		},
 		Sqs: &queue.SqsConfig{
 			QueueURL:        args.SqsQueue,
@@ -71,6 +151,7 @@ func main() {
 			Manager:         manager,
 			Repo:            repo,
 			FilesHandler:    localFileHandler,
+			Webhooks:        webhooks,
 		},
 	})
 
@@ -80,6 +161,30 @@ func main() {
 
 	log.Info().Msg("starting sandbox manager")
 	go manager.Start(context.Background())
+	go publishLanguageStatuses(context.Background(), manager, repo)
+
+	// the loader is only ready once it can consume from the queue, run the
+	// containers of every language and write the results.
+	checker := health.NewChecker(healthCheckTimeout)
+	checker.Register("repository", func(context.Context) error { return repo.Ping() })
+	checker.Register("queue", func(context.Context) error { return queueRunner.Ping() })
+	checker.Register("files", func(context.Context) error { return localFileHandler.Ping() })
+	checker.Register("docker", manager.Ping)
+	checker.Register("language-images", manager.CheckLanguageImages)
+
+	go func() {
+		healthServer := &http.Server{
+			Addr:              args.HealthAddress,
+			Handler:           checker.Handler(),
+			ReadHeaderTimeout: time.Second * 10,
+		}
+
+		log.Info().Msgf("health server listening on %s", args.HealthAddress)
+
+		if healthErr := healthServer.ListenAndServe(); healthErr != nil {
+			log.Fatal().Err(healthErr).Msg("failed to start health server")
+		}
+	}()
 
 	// wait for signal to exit
 	sigChan := make(chan os.Signal, 1)
@@ -88,4 +193,13 @@ func main() {
 
 	manager.Stop()
 	queueRunner.Stop()
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), webhookShutdownTimeout)
+	defer cancelShutdown()
+
+	if err := webhooks.Wait(shutdownCtx); err != nil {
+		log.Warn().Err(err).Msg("abandoning webhooks still being delivered")
+	}
+
+	cancelWebhooks()
 }
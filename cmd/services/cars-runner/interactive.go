@@ -0,0 +1,457 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"compile-and-run-sandbox/internal/memory"
+	"compile-and-run-sandbox/internal/sandbox"
+)
+
+// relayFlushTimeout is the maximum time waited for the remaining exchanged
+// output to be relayed once both processes have exited.
+const relayFlushTimeout = time.Second
+
+// maxInteractiveOutputBytes is the maximum number of bytes of the standard
+// output of the code kept for the response of an interactive run.
+const maxInteractiveOutputBytes = 1024 * 1024
+
+// unprivilegedUser is the user and group the code runs as alongside an
+// interactor, unable to read the private interactor directory owned by root.
+const unprivilegedUser = 65534
+
+// takeInteractorFiles reads the files of the interactor and removes them from
+// the input directory, ensuring the code cannot read them while it compiles.
+func takeInteractorFiles() (map[string][]byte, error) {
+	directory := filepath.Join("/input", sandbox.InteractorDirectory)
+	entries, err := os.ReadDir(directory)
+
+	if err != nil {
+		return nil, err
+	}
+
+	interactorFiles := make(map[string][]byte, len(entries))
+
+	for _, entry := range entries {
+		data, readErr := os.ReadFile(filepath.Join(directory, entry.Name()))
+
+		if readErr != nil {
+			return nil, readErr
+		}
+
+		interactorFiles[entry.Name()] = data
+	}
+
+	return interactorFiles, os.RemoveAll(directory)
+}
+
+// restoreInteractorFiles writes the files of the interactor into the private
+// interactor directory once the code has compiled, and allows the code running
+// as the unprivileged user to read the input directory and write artifacts.
+func restoreInteractorFiles(interactorFiles map[string][]byte) error {
+	if err := os.MkdirAll(sandbox.InteractorPrivateDirectory, 0o700); err != nil {
+		return err
+	}
+
+	for name, data := range interactorFiles {
+		if err := os.WriteFile(filepath.Join(sandbox.InteractorPrivateDirectory, name), data, 0o600); err != nil {
+			return err
+		}
+	}
+
+	// the code must be able to read its own files, including the files of a
+	// project written within their own directories.
+	walkErr := filepath.WalkDir("/input", func(path string, entry os.DirEntry, err error) error {
+		if err != nil || !entry.IsDir() {
+			return err
+		}
+
+		return os.Chmod(path, 0o755) // nolint:gosec // directories of the code
+	})
+
+	if walkErr != nil {
+		return walkErr
+	}
+
+	return os.Chmod(filepath.Join("/input", sandbox.ArtifactsDirectory), 0o777) // nolint:gosec // the code writes artifacts
+}
+
+// publishTranscript copies the transcript of the run into the artifacts
+// directory once the code has exited, replacing anything the code wrote
+// under the same name.
+func publishTranscript(transcriptPath string, index int) error {
+	destinationPath := filepath.Join("/input", sandbox.ArtifactsDirectory, sandbox.TranscriptFileName(index))
+
+	if err := os.Remove(destinationPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	source, err := os.Open(transcriptPath)
+
+	if err != nil {
+		return err
+	}
+
+	defer source.Close()
+
+	// the file is created exclusively, never following a link left in place
+	// of the transcript.
+	destination, err := os.OpenFile(destinationPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+
+	if err != nil {
+		return err
+	}
+
+	defer destination.Close()
+
+	_, err = io.Copy(destination, source)
+	return err
+}
+
+// transcript records everything exchanged between the code and the
+// interactor, each line prefixed with the direction of the exchange.
+type transcript struct {
+	lock    sync.Mutex
+	writer  io.Writer
+	written int64
+	limit   int64
+}
+
+func (t *transcript) record(prefix string, lines []string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, line := range lines {
+		entry := prefix + line + "\n"
+
+		// the transcript stops at the limit instead of recording a partial
+		// line, keeping every recorded line complete.
+		if t.written+int64(len(entry)) > t.limit {
+			return
+		}
+
+		if _, err := io.WriteString(t.writer, entry); err != nil {
+			return
+		}
+
+		t.written += int64(len(entry))
+	}
+}
+
+// cappedBuffer keeps the first bytes written up to the limit, discarding the
+// rest without failing the write.
+type cappedBuffer struct {
+	lock   sync.Mutex
+	buffer bytes.Buffer
+	limit  int
+}
+
+func (c *cappedBuffer) Write(data []byte) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if remaining := c.limit - c.buffer.Len(); remaining > 0 {
+		c.buffer.Write(data[:min(len(data), remaining)])
+	}
+
+	return len(data), nil
+}
+
+// Lines returns the first 1k lines written.
+func (c *cappedBuffer) Lines() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return readOutputLines(bytes.NewReader(c.buffer.Bytes()))
+}
+
+// relay copies everything read from the source into the destination as soon
+// as it is read, recording it within the transcript. The destination is closed
+// once the source has been closed, signalling the end of the input.
+func relay(destination io.WriteCloser, source io.Reader, record *transcript, prefix string, capture io.Writer) {
+	defer destination.Close()
+
+	buffer := make([]byte, 32*1024)
+	writable := true
+
+	// a line can be split across reads, so only complete lines are recorded
+	// until the source has been closed.
+	var pending string
+
+	for {
+		n, err := source.Read(buffer)
+
+		if n > 0 {
+			lines := strings.Split(pending+string(buffer[:n]), "\n")
+			pending = lines[len(lines)-1]
+
+			record.record(prefix, lines[:len(lines)-1])
+			_, _ = capture.Write(buffer[:n])
+
+			// once the destination has exited the remaining output is still
+			// read to ensure the source is never blocked writing.
+			if writable {
+				if _, writeErr := destination.Write(buffer[:n]); writeErr != nil {
+					writable = false
+				}
+			}
+		}
+
+		if err != nil {
+			if pending != "" {
+				record.record(prefix, []string{pending})
+			}
+
+			return
+		}
+	}
+}
+
+// interactiveProcess is a single side of an interactive run, either the code
+// or the interactor.
+type interactiveProcess struct {
+	cmd       *exec.Cmd
+	execution *RunExecution
+	err       error
+	done      chan any
+}
+
+// start starts the process, recording the runtime and the memory of the
+// process until it has exited.
+func (p *interactiveProcess) start(limit memory.Memory, wg *sync.WaitGroup) error {
+	timeAtExecution := time.Now()
+
+	if err := p.cmd.Start(); err != nil {
+		return err
+	}
+
+	go func() {
+		defer close(p.done)
+		_ = p.cmd.Wait()
+		p.execution.runtimeNano = time.Since(timeAtExecution).Nanoseconds()
+	}()
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		p.execution.memoryConsumption, p.err = watchProcess(p.cmd, p.done, limit)
+
+		// the process could have been killed before it exited, so wait for
+		// the exit before reading the state of the process.
+		<-p.done
+
+		if p.cmd.ProcessState != nil {
+			p.execution.exitCode = p.cmd.ProcessState.ExitCode()
+		}
+	}()
+
+	return nil
+}
+
+// newInteractiveProcess creates the process of the command, writing the error
+// output into the file at the given path.
+func newInteractiveProcess(ctx context.Context, command []string, stdin *os.File, stdout *os.File,
+	errorOutputFile *os.File) *interactiveProcess {
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = errorOutputFile
+
+	return &interactiveProcess{
+		cmd:       cmd,
+		execution: &RunExecution{memoryConsumption: memory.Byte},
+		done:      make(chan any),
+	}
+}
+
+// compileInteractor compiles the interactor after the code has been compiled,
+// bound by the same compile timeout.
+func compileInteractor(ctx context.Context, params *sandbox.ExecutionParameters) ([]string, error) {
+	compilerOutput, _, err := compileSteps(ctx, params, params.Interactor.CompileSteps)
+	return compilerOutput, err
+}
+
+// runInteractive runs the code alongside the interactor, the standard output
+// of each is connected to the standard input of the other. Both are bound by
+// the run timeout and the memory limit, each with their own accounting.
+func runInteractive(ctx context.Context, params *sandbox.ExecutionParameters, index int) (solution *interactiveProcess,
+	interactor *interactiveProcess, err error) {
+	log.Info().Str("id", params.ID).Int("index", index).Msg("interactive run start")
+
+	ctx, cancel := context.WithTimeout(ctx, params.RunTimeout)
+	defer cancel()
+
+	solutionCommand, err := parseCommand(params.Run, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	interactorCommand, err := parseCommand(params.Interactor.Run, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if index < len(params.Interactor.RunArguments) {
+		interactorCommand = append(interactorCommand, params.Interactor.RunArguments[index]...)
+	}
+
+	// the transcript is written within the private interactor directory and
+	// only published once the code has exited, so the code cannot alter it.
+	transcriptFile, err := os.Create(filepath.Join(sandbox.InteractorPrivateDirectory, sandbox.TranscriptFileName(index)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer transcriptFile.Close()
+
+	record := &transcript{writer: transcriptFile, limit: params.Interactor.MaxTranscriptBytes}
+
+	// each process reads and writes its own end of the pipes, the other ends
+	// are relayed by the runner allowing the exchange to be recorded.
+	solutionStdin, toSolution, err := os.Pipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fromSolution, solutionStdout, err := os.Pipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	interactorStdin, toInteractor, err := os.Pipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fromInteractor, interactorStdout, err := os.Pipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer fromSolution.Close()
+	defer fromInteractor.Close()
+
+	solutionErrFile, _ := os.Create(fmt.Sprintf("/input/run-error-output-%d", index))
+	interactorErrFile, _ := os.Create(fmt.Sprintf("/input/interactor-error-output-%d", index))
+
+	defer solutionErrFile.Close()
+	defer interactorErrFile.Close()
+
+	solution = newInteractiveProcess(ctx, solutionCommand, solutionStdin, solutionStdout, solutionErrFile)
+	interactor = newInteractiveProcess(ctx, interactorCommand, interactorStdin, interactorStdout, interactorErrFile)
+
+	// the code runs as the unprivileged user within its own process group,
+	// allowing any process left behind by the code to be killed.
+	solution.cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid:    true,
+		Credential: &syscall.Credential{Uid: unprivilegedUser, Gid: unprivilegedUser},
+	}
+
+	solutionOutput := &cappedBuffer{limit: maxInteractiveOutputBytes}
+
+	relays := sync.WaitGroup{}
+	relays.Add(2)
+
+	go func() {
+		defer relays.Done()
+		relay(toInteractor, fromSolution, record, "> ", solutionOutput)
+	}()
+
+	go func() {
+		defer relays.Done()
+		relay(toSolution, fromInteractor, record, "< ", io.Discard)
+	}()
+
+	processes := sync.WaitGroup{}
+	interactorErr := interactor.start(params.ExecutionMemory, &processes)
+
+	if interactorErr == nil {
+		if solutionErr := solution.start(params.ExecutionMemory, &processes); solutionErr != nil {
+			solution.err = solutionErr
+		}
+	} else {
+		interactor.err = interactorErr
+		solution.err = interactorErr
+	}
+
+	// the ends of the pipes used by the processes are closed within the
+	// runner, ensuring the end of the input is seen once a process exits.
+	for _, file := range []*os.File{solutionStdin, solutionStdout, interactorStdin, interactorStdout} {
+		_ = file.Close()
+	}
+
+	processes.Wait()
+
+	if solution.cmd.Process != nil {
+		_ = syscall.Kill(-solution.cmd.Process.Pid, syscall.SIGKILL)
+	}
+
+	// the processes can exit before everything they have written has been
+	// relayed, which must be recorded for the transcript to be complete.
+	relayed := make(chan any)
+
+	go func() {
+		defer close(relayed)
+		relays.Wait()
+	}()
+
+	select {
+	case <-relayed:
+	case <-time.After(relayFlushTimeout):
+		log.Warn().Int("index", index).Msg("timed out relaying interactive output")
+	}
+
+	if publishErr := publishTranscript(transcriptFile.Name(), index); publishErr != nil {
+		log.Error().Err(publishErr).Int("index", index).Msg("failed to publish transcript")
+	}
+
+	solution.execution.standardOutput = solutionOutput.Lines()
+	solution.execution.errorOutput = readOutputFile(solutionErrFile.Name())
+	interactor.execution.errorOutput = readOutputFile(interactorErrFile.Name())
+
+	// We want to check the context error to see if the timeout was executed,
+	// the timeout applies to both of the processes but only the processes
+	// killed by the timeout exceeded it.
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		for _, process := range []*interactiveProcess{solution, interactor} {
+			if process.err == nil && process.execution.exitCode == -1 {
+				process.err = ctx.Err()
+			}
+		}
+	}
+
+	log.Info().Str("id", params.ID).
+		Int64("runtime-duration-nano", solution.execution.runtimeNano).
+		Int64("interactor-runtime-duration-nano", interactor.execution.runtimeNano).
+		Msg("interactive run complete")
+
+	return solution, interactor, nil
+}
+
+// readOutputFile reads the first 1k lines of the output file at the path.
+func readOutputFile(path string) []string {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return []string{}
+	}
+
+	defer file.Close()
+
+	return readOutputLines(file)
+}
@@ -8,8 +8,10 @@ import (
 	"errors"
 	"fmt"
 	"html/template"
+	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"syscall"
 	"time"
@@ -48,6 +50,12 @@ func parseTemplateString(value string, params *sandbox.ExecutionParameters) (str
 }
 
 func compileProject(ctx context.Context, params *sandbox.ExecutionParameters) (compilerOutput []string, compileTimeNano int64, err error) {
+	return compileSteps(ctx, params, params.CompileSteps)
+}
+
+// compileSteps executes each of the compile steps in order, all bound by the
+// compile timeout.
+func compileSteps(ctx context.Context, params *sandbox.ExecutionParameters, steps []string) (compilerOutput []string, compileTimeNano int64, err error) {
 	log.Info().Str("id", params.ID).Msg("compile start")
 
 	// this has to be defined here since we always want this total time
@@ -55,7 +63,7 @@ func compileProject(ctx context.Context, params *sandbox.ExecutionParameters) (c
 	var timeAtExecution time.Time
 	compileTimeNano = 0
 
-	hasSteps := len(params.CompileSteps) > 0
+	hasSteps := len(steps) > 0
 
 	if !hasSteps {
 		return
@@ -75,7 +83,7 @@ func compileProject(ctx context.Context, params *sandbox.ExecutionParameters) (c
 
 	timeAtExecution = time.Now()
 
-	for _, step := range params.CompileSteps {
+	for _, step := range steps {
 		commandString, parseErr := parseTemplateString(step, params)
 		if parseErr != nil {
 			err = parseErr
@@ -113,10 +121,11 @@ type RunExecution struct {
 	errorOutput       []string
 	runtimeNano       int64
 	memoryConsumption memory.Memory
+	exitCode          int
 }
 
-func runProject(ctx context.Context, params *sandbox.ExecutionParameters) (*RunExecution, error) {
-	log.Info().Str("id", params.ID).Msg("run start")
+func runProject(ctx context.Context, params *sandbox.ExecutionParameters, index int) (*RunExecution, error) {
+	log.Info().Str("id", params.ID).Int("index", index).Msg("run start")
 
 	// this has to be defined here since we always want this total time
 	// and the total time is determined in to defer func.
@@ -137,18 +146,23 @@ func runProject(ctx context.Context, params *sandbox.ExecutionParameters) (*RunE
 	defer cancel()
 
 	// Create the command with our context
-	parsedCommand, err := parseTemplateString(params.Run, params)
+	command, err := parseCommand(params.Run, params)
 	if err != nil {
 		return nil, err
 	}
 
-	command := strings.Split(parsedCommand, " ")
+	if index < len(params.RunArguments) {
+		command = append(command, params.RunArguments[index]...)
+	}
 
-	inputFile, _ := os.Open(fmt.Sprintf("/input/%s", params.StandardInput))
+	inputFile, _ := os.Open(fmt.Sprintf("/input/%s", params.StandardInputs[index]))
 	defer inputFile.Close()
 
-	outputFile, _ := os.Create("/input/run-standard-output")
-	outputErrFile, _ := os.Create("/input/run-error-output")
+	outputFilePath := fmt.Sprintf("/input/run-standard-output-%d", index)
+	outputErrFilePath := fmt.Sprintf("/input/run-error-output-%d", index)
+
+	outputFile, _ := os.Create(outputFilePath)
+	outputErrFile, _ := os.Create(outputErrFilePath)
 
 	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
 
@@ -172,7 +186,108 @@ func runProject(ctx context.Context, params *sandbox.ExecutionParameters) (*RunE
 		_ = cmd.Wait()
 	}()
 
-	pidStats := pid.StreamPid(pidDone, cmd.Process.Pid)
+	memoryConsumption, watchErr := watchProcess(cmd, pidDone, params.ExecutionMemory)
+	resp.memoryConsumption = memoryConsumption
+
+	if watchErr != nil {
+		return &resp, watchErr
+	}
+
+	resp.exitCode = cmd.ProcessState.ExitCode()
+
+	// close the file after writing to allow full reading from the start
+	// current implementation does not allow writing and then reading from the
+	// start
+	outputFile.Close()
+	outputErrFile.Close()
+
+	outputFile, _ = os.Open(outputFilePath)
+	outputErrFile, _ = os.Open(outputErrFilePath)
+
+	// only take the first 1k from both the error output and the standard output
+	// this is by design to stop the chance of people abusing the system and
+	// writing infinitely to the output streams until it crashes the memory
+	// on read.
+	outputLines := readOutputLines(outputFile)
+	outputErrLines := readOutputLines(outputErrFile)
+
+	log.Debug().
+		Strs("outlines", outputLines).
+		Strs("errLines", outputErrLines).
+		Msg("output")
+
+	resp.standardOutput = outputLines
+	resp.errorOutput = outputErrLines
+
+	// We want to check the context error to see if the timeout was executed.
+	// The error returned by cmd.Output() will be OS specific based on what
+	// happens when a process is killed.
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return &resp, ctx.Err()
+	}
+
+	return &resp, cmdErr
+}
+
+// runInteractiveProject runs the code alongside the interactor, returning the
+// response of the run including the results of the interactor.
+func runInteractiveProject(ctx context.Context, params *sandbox.ExecutionParameters, index int) *sandbox.ExecutionRunResponse {
+	solution, interactor, err := runInteractive(ctx, params, index)
+
+	if err != nil {
+		log.Error().Err(err).Int("index", index).Msg("error occurred when running interactive code")
+
+		return &sandbox.ExecutionRunResponse{
+			Status:           sandbox.RunTimeError,
+			InteractorStatus: sandbox.NotRan,
+		}
+	}
+
+	runStatus := sandbox.Finished
+	interactorStatus := sandbox.Finished
+
+	if solution.err != nil {
+		log.Error().Err(solution.err).Int("index", index).Msg("error occurred when running code")
+		runStatus = determineExecutionError(solution.err)
+	}
+
+	if interactor.err != nil {
+		log.Error().Err(interactor.err).Int("index", index).Msg("error occurred when running interactor")
+		interactorStatus = determineExecutionError(interactor.err)
+	}
+
+	return &sandbox.ExecutionRunResponse{
+		Output:             solution.execution.standardOutput,
+		OutputErr:          solution.execution.errorOutput,
+		Runtime:            solution.execution.runtimeNano,
+		RuntimeMemoryBytes: solution.execution.memoryConsumption.Bytes(),
+		Status:             runStatus,
+		ExitCode:           solution.execution.exitCode,
+
+		InteractorOutputErr:          interactor.execution.errorOutput,
+		InteractorRuntime:            interactor.execution.runtimeNano,
+		InteractorRuntimeMemoryBytes: interactor.execution.memoryConsumption.Bytes(),
+		InteractorStatus:             interactorStatus,
+		InteractorExitCode:           interactor.execution.exitCode,
+	}
+}
+
+// parseCommand parses the template of the command, splitting the command into
+// the name and the arguments.
+func parseCommand(value string, params *sandbox.ExecutionParameters) ([]string, error) {
+	parsedCommand, err := parseTemplateString(value, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(parsedCommand, " "), nil
+}
+
+// watchProcess records the maximum memory used by the started command until
+// done has been closed, killing the command once it exceeds the memory limit.
+func watchProcess(cmd *exec.Cmd, done chan any, limit memory.Memory) (memory.Memory, error) {
+	memoryConsumption := memory.Byte
+	pidStats := pid.StreamPid(done, cmd.Process.Pid)
 
 	sampleLogger := log.Sample(&zerolog.BurstSampler{
 		Burst:       5,
@@ -181,24 +296,24 @@ func runProject(ctx context.Context, params *sandbox.ExecutionParameters) (*RunE
 	})
 
 	for state := range pidStats {
-		if state.Memory > resp.memoryConsumption {
-			resp.memoryConsumption = state.Memory
+		if state.Memory > memoryConsumption {
+			memoryConsumption = state.Memory
 
 			sampleLogger.Debug().
 				Int("pid", cmd.Process.Pid).
-				Float64("memory-mb", resp.memoryConsumption.Megabytes()).
-				Float64("max-memory-mb", params.ExecutionMemory.Megabytes()).
+				Float64("memory-mb", memoryConsumption.Megabytes()).
+				Float64("max-memory-mb", limit.Megabytes()).
 				Msg("state-metrics")
 
-			if resp.memoryConsumption > params.ExecutionMemory {
+			if memoryConsumption > limit {
 				log.Info().
 					Int("pid", cmd.Process.Pid).
-					Float64("memory-mb", resp.memoryConsumption.Megabytes()).
-					Float64("max-memory-mb", params.ExecutionMemory.Megabytes()).
+					Float64("memory-mb", memoryConsumption.Megabytes()).
+					Float64("max-memory-mb", limit.Megabytes()).
 					Msg("state-metrics")
 
 				_ = cmd.Process.Kill()
-				return &resp, memory.LimitExceeded
+				return memoryConsumption, memory.LimitExceeded
 			}
 		}
 	}
@@ -210,81 +325,64 @@ func runProject(ctx context.Context, params *sandbox.ExecutionParameters) (*RunE
 
 	log.Info().
 		Int("pid", cmd.Process.Pid).
-		Float64("pid-max-memory-mb", resp.memoryConsumption.Megabytes()).
-		Float64("container-max-memory-mb", params.ExecutionMemory.Megabytes()).
+		Float64("pid-max-memory-mb", memoryConsumption.Megabytes()).
+		Float64("container-max-memory-mb", limit.Megabytes()).
 		Float64("process-state-max-memory-mb", finalProcessMaxMemory.Megabytes()).
 		Msg("state-metrics")
 
-	if finalProcessMaxMemory > resp.memoryConsumption {
-		resp.memoryConsumption = finalProcessMaxMemory
+	if finalProcessMaxMemory > memoryConsumption {
+		memoryConsumption = finalProcessMaxMemory
 	}
 
 	// last check for being over the memory limit, this can catch anything that
 	// happened after the final recording but the cmd process managed to get
 	// determine a higher value.
-	if resp.memoryConsumption > params.ExecutionMemory {
-		return &resp, memory.LimitExceeded
+	if memoryConsumption > limit {
+		return memoryConsumption, memory.LimitExceeded
 	}
 
-	// close the file after writing to allow full reading from the start
-	// current implementation does not allow writing and then reading from the
-	// start
-	outputFile.Close()
-	outputErrFile.Close()
-
-	outputFile, _ = os.Open("/input/run-standard-output")
-	outputErrFile, _ = os.Open("/input/run-error-output")
+	return memoryConsumption, nil
+}
 
-	// only take the first 1k from both the error output and the standard output
-	// this is by design to stop the chance of people abusing the system and
-	// writing infinitely to the output streams until it crashes the memory
-	// on read.
-	scanner := bufio.NewScanner(outputFile)
+// readOutputLines reads the first 1k lines of the output file.
+func readOutputLines(file io.Reader) []string {
+	scanner := bufio.NewScanner(file)
 	scanner.Split(bufio.ScanLines)
 
-	outputLines := make([]string, 0)
-	var outputLinesCount int
+	lines := make([]string, 0)
 
 	for scanner.Scan() {
-		outputLines = append(outputLines, scanner.Text())
-		outputLinesCount++
+		lines = append(lines, scanner.Text())
 
-		if outputLinesCount >= 1_000 {
+		if len(lines) >= 1_000 {
 			break
 		}
 	}
 
-	outputErrLines := make([]string, 0)
-	var outputErrLinesCount int
+	return lines
+}
 
-	scanner = bufio.NewScanner(outputErrFile)
-	scanner.Split(bufio.ScanLines)
+// copyBinary copies the compiled binary into the artifacts directory, keeping
+// the name of the binary.
+func copyBinary(binaryFile string, artifactsDirectory string) error {
+	source, err := os.Open(binaryFile)
 
-	for scanner.Scan() {
-		outputErrLines = append(outputErrLines, scanner.Text())
-		outputErrLinesCount++
-
-		if outputErrLinesCount >= 1_000 {
-			break
-		}
+	if err != nil {
+		return err
 	}
 
-	log.Debug().
-		Strs("outlines", outputLines).
-		Strs("errLines", outputErrLines).
-		Msg("output")
+	defer source.Close()
 
-	resp.standardOutput = outputLines
-	resp.errorOutput = outputErrLines
+	destination, err := os.Create(filepath.Join(artifactsDirectory, filepath.Base(binaryFile)))
 
-	// We want to check the context error to see if the timeout was executed.
-	// The error returned by cmd.Output() will be OS specific based on what
-	// happens when a process is killed.
-	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
-		return &resp, ctx.Err()
+	if err != nil {
+		return err
 	}
 
-	return &resp, cmdErr
+	defer destination.Close()
+
+	_, err = io.Copy(destination, source)
+	return err
 }
 
 func main() {
@@ -307,41 +405,111 @@ func main() {
 
 	responseCode := sandbox.Finished
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	var interactorFiles map[string][]byte
+
+	// the files of the interactor are held by the runner while the code
+	// compiles, the compile runs with the same privileges as the runner.
+	if params.Interactor != nil {
+		var takeErr error
+
+		if interactorFiles, takeErr = takeInteractorFiles(); takeErr != nil {
+			log.Fatal().Err(takeErr).Msg("failed to take the interactor files")
+		}
+	}
+
+	// the overall timeout must allow for the compile and each of the runs, all
+	// of which are individually bound by their own timeouts.
+	overallTimeout := params.CompileTimeout + params.RunTimeout*time.Duration(len(params.StandardInputs))
 
-	var runExecution = &RunExecution{}
-	var compilerOutput []string
-	var compileTime int64
-	var compileErr, runtimeErr error
+	if params.Interactor != nil {
+		overallTimeout += params.CompileTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), overallTimeout)
+	defer cancel()
 
 	// configure the file for th compiled output, this is the text
 	// outputted when the compiler is running.
-	compilerOutput, compileTime, compileErr = compileProject(ctx, &params)
+	compilerOutput, compileTime, compileErr := compileProject(ctx, &params)
 
 	if compileErr != nil {
 		log.Error().Err(compileErr).Msg("error occurred when executing compile")
-		responseCode = determineExecutionError(runtimeErr)
+		responseCode = determineExecutionError(compileErr)
+	}
+
+	// the interactor is compiled after the code, failing to compile the
+	// interactor is not a failure of the code so is reported separately.
+	if responseCode == sandbox.Finished && params.Interactor != nil {
+		if restoreErr := restoreInteractorFiles(interactorFiles); restoreErr != nil {
+			log.Fatal().Err(restoreErr).Msg("failed to restore the interactor files")
+		}
+
+		interactorOutput, interactorErr := compileInteractor(ctx, &params)
+
+		if interactorErr != nil {
+			log.Error().Err(interactorErr).Msg("error occurred when compiling interactor")
+			compilerOutput = append(compilerOutput, "interactor compilation failed:")
+			compilerOutput = append(compilerOutput, interactorOutput...)
+			responseCode = sandbox.NonDeterministicError
+		}
 	}
 
-	// output file for the actual execution
+	runs := make([]*sandbox.ExecutionRunResponse, 0, len(params.StandardInputs))
+
+	// the code is compiled once and then executed once per standard input, each
+	// run has its own time and memory accounting.
 	if responseCode == sandbox.Finished {
-		runExecution, runtimeErr = runProject(ctx, &params)
+		for i := range params.StandardInputs {
+			if params.Interactor != nil {
+				runs = append(runs, runInteractiveProject(ctx, &params, i))
+				continue
+			}
+
+			runStatus := sandbox.Finished
+			runExecution, runtimeErr := runProject(ctx, &params, i)
+
+			if runtimeErr != nil {
+				log.Error().Err(runtimeErr).Int("index", i).Msg("error occurred when running code")
+				runStatus = determineExecutionError(runtimeErr)
+			}
+
+			if runExecution == nil {
+				runExecution = &RunExecution{}
+			}
 
-		if runtimeErr != nil {
-			log.Error().Err(runtimeErr).Msg("error occurred when running code")
-			responseCode = determineExecutionError(runtimeErr)
+			runs = append(runs, &sandbox.ExecutionRunResponse{
+				Output:             runExecution.standardOutput,
+				OutputErr:          runExecution.errorOutput,
+				Runtime:            runExecution.runtimeNano,
+				RuntimeMemoryBytes: runExecution.memoryConsumption.Bytes(),
+				Status:             runStatus,
+				ExitCode:           runExecution.exitCode,
+			})
 		}
 	}
 
+	artifactsDirectory := fmt.Sprintf("/input/%s", sandbox.ArtifactsDirectory)
+
+	// the binary is copied after the runs to ensure the code cannot replace it
+	// with a file of the same name.
+	if compileErr == nil && params.BinaryFile != "" {
+		if err := copyBinary(params.BinaryFile, artifactsDirectory); err != nil {
+			log.Error().Err(err).Msg("failed to copy binary into artifacts")
+		}
+	}
+
+	artifacts, artifactsErr := sandbox.CollectArtifacts(artifactsDirectory, params.ArtifactsMaxBytes, params.ArtifactsMaxFiles)
+
+	if artifactsErr != nil {
+		log.Error().Err(artifactsErr).Msg("failed to collect artifacts")
+	}
+
 	executionResponse := sandbox.ExecutionResponse{
-		CompileTime:        compileTime,
-		CompilerOutput:     compilerOutput,
-		Output:             runExecution.standardOutput,
-		OutputErr:          runExecution.errorOutput,
-		Runtime:            runExecution.runtimeNano,
-		RuntimeMemoryBytes: runExecution.memoryConsumption.Bytes(),
-		Status:             responseCode,
+		CompileTime:    compileTime,
+		CompilerOutput: compilerOutput,
+		Runs:           runs,
+		Status:         responseCode,
+		Artifacts:      artifacts,
 	}
 
 	log.Debug().Interface("response", &executionResponse).Msg("response")
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/namsral/flag"
+
+	"compile-and-run-sandbox/internal/auth"
+	"compile-and-run-sandbox/internal/repository"
+)
+
+// Creates a new api key for the given tenant, printing the key once. Only the
+// hash of the key is stored, so the key cannot be recovered afterwards.
+func main() {
+	var (
+		databaseConn string
+		tenantID     string
+		name         string
+	)
+
+	flag.StringVar(&databaseConn, "database-connection-string", "host=localhost user=root password=root port=54320 dbname=compile TimeZone=UTC", "")
+	flag.StringVar(&tenantID, "tenant", "", "")
+	flag.StringVar(&name, "name", "", "")
+
+	flag.Parse()
+
+	if strings.TrimSpace(tenantID) == "" {
+		log.Fatalln("a tenant is required to create an api key")
+	}
+
+	repo, err := repository.NewRepository(databaseConn)
+
+	if err != nil {
+		log.Fatalf("failed to create repository: %s\n", err)
+	}
+
+	key, err := auth.GenerateKey()
+
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := repo.InsertAPIKey(&repository.APIKey{
+		Hash:     auth.HashKey(key),
+		TenantID: tenantID,
+		Name:     name,
+	}); err != nil {
+		log.Fatalf("failed to store api key: %s\n", err)
+	}
+
+	fmt.Println(key)
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/namsral/flag"
+
+	"compile-and-run-sandbox/internal/repository"
+)
+
+// Sets the limits of the given tenant, replacing any existing limits. Limits
+// left as zero fall back to the defaults configured on the API.
+func main() {
+	var (
+		databaseConn string
+		limit        repository.TenantLimit
+	)
+
+	flag.StringVar(&databaseConn, "database-connection-string", "host=localhost user=root password=root port=54320 dbname=compile TimeZone=UTC", "")
+	flag.StringVar(&limit.TenantID, "tenant", "", "")
+	flag.Float64Var(&limit.RequestsPerSecond, "requests-per-second", 0, "")
+	flag.IntVar(&limit.RequestBurst, "burst", 0, "")
+	flag.Int64Var(&limit.MaxInFlightExecutions, "max-in-flight-executions", 0, "")
+
+	flag.Parse()
+
+	if strings.TrimSpace(limit.TenantID) == "" {
+		log.Fatalln("a tenant is required to set limits")
+	}
+
+	repo, err := repository.NewRepository(databaseConn)
+
+	if err != nil {
+		log.Fatalf("failed to create repository: %s\n", err)
+	}
+
+	if err := repo.UpsertTenantLimit(&limit); err != nil {
+		log.Fatalf("failed to store tenant limits: %s\n", err)
+	}
+
+	fmt.Printf("updated limits of tenant %s\n", limit.TenantID)
+}
@@ -0,0 +1,97 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	consumerv1 "compile-and-run-sandbox/internal/gen/pb/content/consumer/v1"
+	"compile-and-run-sandbox/internal/sandbox"
+)
+
+// artifactChunkSize is the maximum number of bytes sent within a single
+// message when downloading an artifact.
+const artifactChunkSize = 64 * 1024
+
+// ListArtifacts returns the artifacts collected from the execution.
+func (s Server) ListArtifacts(ctx context.Context, in *consumerv1.ListArtifactsRequest) (*consumerv1.ListArtifactsResponse, error) {
+	parsedIDValue, err := uuid.Parse(in.GetId())
+
+	if err != nil {
+		return nil, invalidIDError("id")
+	}
+
+	execution, err := s.getExecution(ctx, parsedIDValue.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts, err := s.Repo.GetArtifacts(execution.ID)
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get artifacts")
+		return nil, status.Error(codes.Internal, "failed to get artifacts")
+	}
+
+	resp := &consumerv1.ListArtifactsResponse{
+		Artifacts: make([]*consumerv1.Artifact, 0, len(artifacts)),
+	}
+
+	for _, artifact := range artifacts {
+		resp.Artifacts = append(resp.Artifacts, &consumerv1.Artifact{
+			Path:      artifact.Path,
+			SizeBytes: artifact.SizeBytes,
+		})
+	}
+
+	return resp, nil
+}
+
+// DownloadArtifact streams the content of the artifact in chunks.
+func (s Server) DownloadArtifact(in *consumerv1.DownloadArtifactRequest, stream consumerv1.ConsumerService_DownloadArtifactServer) error {
+	parsedIDValue, err := uuid.Parse(in.GetId())
+
+	if err != nil {
+		return invalidIDError("id")
+	}
+
+	execution, err := s.getExecution(stream.Context(), parsedIDValue.String())
+
+	if err != nil {
+		return err
+	}
+
+	artifact, err := s.Repo.GetArtifact(execution.ID, in.GetPath())
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errArtifactNotFound
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get artifact")
+		return status.Error(codes.Internal, "failed to get artifact")
+	}
+
+	data, err := s.FileHandler.GetFile(execution.ID, sandbox.ArtifactFileName(artifact.Path))
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to read artifact")
+		return status.Error(codes.Unavailable, "failed to read artifact")
+	}
+
+	for offset := 0; offset < len(data); offset += artifactChunkSize {
+		end := min(offset+artifactChunkSize, len(data))
+
+		if err := stream.Send(&consumerv1.DownloadArtifactResponse{Chunk: data[offset:end]}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"compile-and-run-sandbox/internal/auth"
+)
+
+// auditLog returns a log event containing the tenant and the client
+// certificate identity of the request, used to audit changes to executions.
+func auditLog(ctx context.Context) *zerolog.Event {
+	event := log.Info().Str("tenant", auth.TenantID(ctx))
+
+	if identity, ok := auth.ClientIdentityFromContext(ctx); ok {
+		event = event.
+			Str("client_common_name", identity.CommonName).
+			Str("client_serial_number", identity.SerialNumber)
+	}
+
+	return event
+}
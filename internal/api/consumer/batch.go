@@ -0,0 +1,209 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"compile-and-run-sandbox/internal/auth"
+	"compile-and-run-sandbox/internal/files"
+	consumerv1 "compile-and-run-sandbox/internal/gen/pb/content/consumer/v1"
+	"compile-and-run-sandbox/internal/queue"
+	"compile-and-run-sandbox/internal/repository"
+	"compile-and-run-sandbox/internal/sandbox"
+	"compile-and-run-sandbox/internal/validation"
+)
+
+// CreateCompileBatch validates and enqueues all the compile requests as a
+// single batch. The executions are inserted within a single transaction, the
+// source files written concurrently and the messages published together.
+func (s Server) CreateCompileBatch(ctx context.Context, in *consumerv1.CreateCompileBatchRequest) (*consumerv1.CreateCompileBatchResponse, error) {
+	batch := &repository.Batch{
+		ID:       uuid.NewString(),
+		TenantID: auth.TenantID(ctx),
+		Total:    int64(len(in.Requests)),
+	}
+
+	sourceFiles := make([]*files.File, 0, len(in.Requests))
+	executions := make([]*repository.Execution, 0, len(in.Requests))
+	messages := make([][]byte, 0, len(in.Requests))
+	ids := make([]string, 0, len(in.Requests))
+
+	for i, request := range in.Requests {
+		if request.IdempotencyKey != "" {
+			return nil, validation.NewError("idempotency_key", "idempotency keys are not supported within a batch").
+				WithPrefix(fmt.Sprintf("requests[%d]", i))
+		}
+
+		compileMsg, requestFiles, err := s.prepareCompileMessage(batch.TenantID, request)
+
+		var validationErr *validation.Error
+
+		if errors.As(err, &validationErr) {
+			return nil, validationErr.WithPrefix(fmt.Sprintf("requests[%d]", i))
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		bytes, _ := json.Marshal(compileMsg)
+
+		sourceFiles = append(sourceFiles, requestFiles...)
+		executions = append(executions, newExecution(compileMsg, batch.TenantID))
+		messages = append(messages, bytes)
+		ids = append(ids, compileMsg.ID)
+	}
+
+	limit := s.inFlightLimit(batch.TenantID)
+	err := s.Repo.InsertBatch(batch, executions, limit)
+
+	if errors.Is(err, repository.ErrInFlightLimitReached) {
+		return nil, inFlightLimitError(ctx, limit)
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create batch record")
+		return nil, status.Error(codes.Internal, "failed to create batch record")
+	}
+
+	if errs := s.FileHandler.WriteFiles(sourceFiles...); len(errs) > 0 {
+		log.Error().Errs("errors", errs).Msg("failed to write batch source files")
+		s.failExecutions(ids...)
+		return nil, status.Error(codes.Unavailable, "failed to write source files")
+	}
+
+	results := make([]*consumerv1.CreateCompileBatchResult, 0, len(ids))
+
+	for _, id := range ids {
+		results = append(results, &consumerv1.CreateCompileBatchResult{Id: id, Queued: true})
+	}
+
+	if err := s.Queue.SubmitMessagesToQueue(messages); err != nil {
+		log.Error().Err(err).Msg("failed to submit batch to queue")
+
+		// the executions which were not queued will never be picked up by the
+		// loader, they are marked as failed so they no longer count as in-flight.
+		failed := failedSubmissions(err, len(messages))
+		failedIDs := make([]string, 0, len(failed))
+
+		for _, index := range failed {
+			results[index].Queued = false
+			results[index].Error = "failed to queue compile request"
+			failedIDs = append(failedIDs, ids[index])
+		}
+
+		s.failExecutions(failedIDs...)
+
+		if len(failed) == len(messages) {
+			return nil, status.Error(codes.Unavailable, "failed to execute compile batch request")
+		}
+	}
+
+	auditLog(ctx).Str("batch_id", batch.ID).Int64("total", batch.Total).Msg("created batch")
+
+	return &consumerv1.CreateCompileBatchResponse{
+		BatchId: batch.ID,
+		Ids:     ids,
+		Results: results,
+	}, nil
+}
+
+// failedSubmissions returns the indexes of the messages which failed to be
+// submitted to the queue, all of them unless only some failed.
+func failedSubmissions(err error, total int) []int {
+	var submitErr *queue.SubmitError
+
+	if errors.As(err, &submitErr) {
+		return submitErr.Failed
+	}
+
+	failed := make([]int, 0, total)
+
+	for i := 0; i < total; i++ {
+		failed = append(failed, i)
+	}
+
+	return failed
+}
+
+// GetBatchResult returns the progress of the batch, an execution is considered
+// complete once it has reached a terminal status.
+func (s Server) GetBatchResult(ctx context.Context, in *consumerv1.GetBatchResultRequest) (*consumerv1.GetBatchResultResponse, error) {
+	parsedIDValue, err := uuid.Parse(in.GetId())
+
+	if err != nil {
+		return nil, invalidIDError("id")
+	}
+
+	batch, err := s.Repo.GetBatch(parsedIDValue.String())
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errBatchNotFound
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get batch")
+		return nil, status.Error(codes.Internal, "failed to get batch")
+	}
+
+	if batch.TenantID != auth.TenantID(ctx) {
+		return nil, errBatchNotFound
+	}
+
+	counts, err := s.Repo.GetBatchStatusCounts(batch.ID)
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get batch status counts")
+		return nil, status.Error(codes.Internal, "failed to get batch status counts")
+	}
+
+	resp := &consumerv1.GetBatchResultResponse{Total: batch.Total}
+
+	statusCounts := map[string]int64{}
+	testStatusCounts := map[string]int64{}
+
+	for _, count := range counts {
+		statusCounts[count.Status] += count.Count
+		testStatusCounts[count.TestStatus] += count.Count
+
+		if status, _ := sandbox.ParseContainerStatus(count.Status); status.IsTerminal() {
+			resp.Completed += count.Count
+		}
+	}
+
+	if batch.Total > 0 {
+		resp.CompletionPercentage = float64(resp.Completed) / float64(batch.Total) * 100
+	}
+
+	resp.StatusCounts = toStatusCounts(statusCounts)
+	resp.TestStatusCounts = toStatusCounts(testStatusCounts)
+
+	return resp, nil
+}
+
+// toStatusCounts converts the counts into a list ordered by the status name.
+func toStatusCounts(counts map[string]int64) []*consumerv1.StatusCount {
+	statusCounts := make([]*consumerv1.StatusCount, 0, len(counts))
+
+	for status, count := range counts {
+		statusCounts = append(statusCounts, &consumerv1.StatusCount{
+			Status: status,
+			Count:  count,
+		})
+	}
+
+	sort.Slice(statusCounts, func(i, j int) bool {
+		return statusCounts[i].Status < statusCounts[j].Status
+	})
+
+	return statusCounts
+}
@@ -0,0 +1,97 @@
+package consumer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"compile-and-run-sandbox/internal/auth"
+	consumerv1 "compile-and-run-sandbox/internal/gen/pb/content/consumer/v1"
+	"compile-and-run-sandbox/internal/queue"
+)
+
+func TestCreateCompileBatch(t *testing.T) {
+	request := func(source string, idempotencyKey string) *consumerv1.CreateCompileRequest {
+		return &consumerv1.CreateCompileRequest{Language: "python", Source: source, IdempotencyKey: idempotencyKey}
+	}
+
+	tests := []struct {
+		name       string
+		requests   []*consumerv1.CreateCompileRequest
+		queueErr   error
+		wantCode   codes.Code
+		wantFields []string
+		wantQueued []bool
+		wantFailed []int
+	}{{
+		name:       "should queue every request",
+		requests:   []*consumerv1.CreateCompileRequest{request("print(1)", ""), request("print(2)", "")},
+		wantQueued: []bool{true, true},
+	}, {
+		name:       "should reject an idempotency key within the batch",
+		requests:   []*consumerv1.CreateCompileRequest{request("print(1)", ""), request("print(2)", "key")},
+		wantCode:   codes.InvalidArgument,
+		wantFields: []string{"requests[1].idempotency_key"},
+	}, {
+		name:       "should prefix the violations with the request",
+		requests:   []*consumerv1.CreateCompileRequest{request("print(1)", ""), request("", "")},
+		wantCode:   codes.InvalidArgument,
+		wantFields: []string{"requests[1].source"},
+	}, {
+		name:       "should report the requests which failed to queue",
+		requests:   []*consumerv1.CreateCompileRequest{request("print(1)", ""), request("print(2)", ""), request("print(3)", "")},
+		queueErr:   &queue.SubmitError{Failed: []int{1}, Err: errors.New("queue unavailable")},
+		wantQueued: []bool{true, false, true},
+		wantFailed: []int{1},
+	}, {
+		name:       "should fail if every request failed to queue",
+		requests:   []*consumerv1.CreateCompileRequest{request("print(1)", ""), request("print(2)", "")},
+		queueErr:   errors.New("queue unavailable"),
+		wantCode:   codes.Unavailable,
+		wantFailed: []int{0, 1},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &executionRepository{}
+
+			server := Server{Repo: repo, Queue: &recordingQueue{err: tt.queueErr}, FileHandler: &memoryFiles{}}
+			ctx := auth.NewContext(context.Background(), &auth.Tenant{ID: "tenant"})
+
+			resp, err := server.CreateCompileBatch(ctx, &consumerv1.CreateCompileBatchRequest{Requests: tt.requests})
+
+			assert.Equal(t, tt.wantCode, status.Code(err), err)
+
+			if tt.wantFields != nil {
+				assert.Equal(t, tt.wantFields, violationFields(t, err))
+				assert.Empty(t, repo.executions)
+				return
+			}
+
+			failed := make([]string, 0, len(tt.wantFailed))
+
+			for _, index := range tt.wantFailed {
+				failed = append(failed, repo.executions[index].ID)
+			}
+
+			assert.ElementsMatch(t, failed, repo.failed)
+
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			queued := make([]bool, 0, len(resp.Results))
+
+			for i, result := range resp.Results {
+				assert.Equal(t, resp.Ids[i], result.Id)
+				queued = append(queued, result.Queued)
+			}
+
+			assert.Equal(t, tt.wantQueued, queued)
+		})
+	}
+}
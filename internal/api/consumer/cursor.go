@@ -0,0 +1,39 @@
+package consumer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"compile-and-run-sandbox/internal/repository"
+)
+
+// encodePageToken encodes the cursor into an opaque page token which can be
+// returned to the consumer.
+func encodePageToken(cursor *repository.ExecutionCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken decodes the opaque page token into the cursor it was
+// created from, returning nil if no token was provided.
+func decodePageToken(token string) (*repository.ExecutionCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode page token")
+	}
+
+	var cursor repository.ExecutionCursor
+
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, errors.Wrap(err, "failed to parse page token")
+	}
+
+	return &cursor, nil
+}
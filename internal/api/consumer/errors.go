@@ -0,0 +1,20 @@
+package consumer
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"compile-and-run-sandbox/internal/validation"
+)
+
+var (
+	errExecutionNotFound = status.Error(codes.NotFound, "the execution does not exist by the provided id")
+	errBatchNotFound     = status.Error(codes.NotFound, "the batch does not exist by the provided id")
+	errArtifactNotFound  = status.Error(codes.NotFound, "the artifact does not exist by the provided path")
+)
+
+// invalidIDError returns the invalid argument error of an id field which is
+// not a valid uuid.
+func invalidIDError(field string) error {
+	return validation.NewError(field, "failed to parse id value")
+}
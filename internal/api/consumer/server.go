@@ -4,25 +4,76 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 
+	"compile-and-run-sandbox/internal/auth"
 	"compile-and-run-sandbox/internal/files"
 	consumerv1 "compile-and-run-sandbox/internal/gen/pb/content/consumer/v1"
 	"compile-and-run-sandbox/internal/queue"
+	"compile-and-run-sandbox/internal/ratelimit"
 	"compile-and-run-sandbox/internal/repository"
 	"compile-and-run-sandbox/internal/sandbox"
+	"compile-and-run-sandbox/internal/source"
+	"compile-and-run-sandbox/internal/validation"
+	"compile-and-run-sandbox/internal/webhook"
 
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// watchPollInterval is the interval in which the repository is checked for
+// status changes while watching a compile result.
+const watchPollInterval = time.Millisecond * 250
+
+// compileAndWaitDeadlineMargin is the time before the deadline of the caller in
+// which compile and wait stops waiting, allowing the response to be returned.
+const compileAndWaitDeadlineMargin = time.Millisecond * 250
+
+// compileAndWaitMaxDuration is the maximum time compile and wait will wait for
+// the execution to complete when the caller has not provided a deadline.
+const compileAndWaitMaxDuration = time.Minute
+
+// idempotencyKeyRetention is the duration in which a repeated request with the
+// same idempotency key returns the original execution.
+const idempotencyKeyRetention = time.Hour * 24
+
+// idempotencyClaimTimeout is the duration after which a claim of an
+// idempotency key still in progress is considered abandoned, e.g. the server
+// stopped while creating the execution, allowing the key to be claimed again.
+const idempotencyClaimTimeout = time.Minute
+
+// defaultListPageSize is the number of executions returned when listing
+// executions without a page size.
+const defaultListPageSize = 25
+
+// languageStatusStaleAfter is the duration after which a language status which
+// has not been checked again by a loader is considered unavailable.
+const languageStatusStaleAfter = time.Minute * 3
+
+// inFlightRetryAfter is the duration the caller is asked to wait before
+// retrying after reaching the in-flight execution limit.
+const inFlightRetryAfter = time.Second * 5
+
+// inFlightStatuses are the statuses of executions which are either queued or
+// running, these executions can still be cancelled.
+var inFlightStatuses = []string{
+	sandbox.NotRan.String(),
+	sandbox.Created.String(),
+	sandbox.Running.String(),
+}
+
 type Server struct {
 	consumerv1.UnimplementedConsumerServiceServer
 
@@ -31,21 +82,256 @@ type Server struct {
 	Translator  ut.Translator
 	Validator   *validator.Validate
 	Queue       queue.Queue
+	Limiter     *ratelimit.Limiter
+
+	// SourceLimits are the limits of the sources uploaded with UploadSource.
+	SourceLimits source.Limits
+
+	// AllowPrivateCallbacks allows callback urls of loopback, link-local and
+	// private addresses, the same as the loader delivering the webhooks.
+	AllowPrivateCallbacks bool
 }
 
-func (s Server) GetCompileResultRequest(_ context.Context, in *consumerv1.GetCompileResultRequest) (*consumerv1.GetCompileResultResponse, error) {
+func (s Server) GetCompileResult(ctx context.Context, in *consumerv1.GetCompileResultRequest) (*consumerv1.GetCompileResultResponse, error) {
 	parsedIDValue, err := uuid.Parse(in.GetId())
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse id value")
+		return nil, invalidIDError("id")
 	}
 
-	execution, err := s.Repo.GetExecution(parsedIDValue.String())
+	execution, err := s.getExecution(ctx, parsedIDValue.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getCompileResultResponse(&execution), nil
+}
+
+// getExecution returns the execution by the id as long as it belongs to the
+// tenant of the request. Executions of other tenants are reported as not found
+// to avoid leaking which ids exist.
+func (s Server) getExecution(ctx context.Context, id string) (repository.Execution, error) {
+	execution, err := s.Repo.GetExecution(id)
 
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, fmt.Errorf("the execution does not exist by the provided id")
+		return execution, errExecutionNotFound
+	}
+
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("failed to get execution")
+		return execution, status.Error(codes.Internal, "failed to get execution")
+	}
+
+	if execution.TenantID != auth.TenantID(ctx) {
+		return repository.Execution{}, errExecutionNotFound
+	}
+
+	return execution, nil
+}
+
+// WatchCompileResult streams every status transition of the execution until
+// it reaches a terminal status or the client cancels. The repository is polled
+// since the loader updating the execution can be running in another process.
+func (s Server) WatchCompileResult(in *consumerv1.WatchCompileResultRequest, stream consumerv1.ConsumerService_WatchCompileResultServer) error {
+	parsedIDValue, err := uuid.Parse(in.GetId())
+
+	if err != nil {
+		return invalidIDError("id")
+	}
+
+	execution, err := s.watchExecution(stream.Context(), parsedIDValue.String(), func(execution *repository.Execution) error {
+		return stream.Send(&consumerv1.WatchCompileResultResponse{
+			Status:     execution.Status,
+			TestStatus: execution.TestStatus,
+		})
+	})
+
+	if ctxErr := stream.Context().Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&consumerv1.WatchCompileResultResponse{
+		Status:     execution.Status,
+		TestStatus: execution.TestStatus,
+		Result:     s.getCompileResultResponse(execution),
+	})
+}
+
+// watchExecution polls the execution until it reaches a terminal status, which
+// is then returned, calling onChange for every non-terminal status change. If
+// the context is done first the last known execution is returned with the
+// context error.
+func (s Server) watchExecution(ctx context.Context, id string, onChange func(execution *repository.Execution) error) (*repository.Execution, error) {
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	var lastStatus, lastTestStatus string
+
+	for {
+		execution, err := s.getExecution(ctx, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		containerStatus, _ := sandbox.ParseContainerStatus(execution.Status)
+
+		if containerStatus.IsTerminal() {
+			return &execution, nil
+		}
+
+		if execution.Status != lastStatus || execution.TestStatus != lastTestStatus {
+			lastStatus, lastTestStatus = execution.Status, execution.TestStatus
+
+			if changeErr := onChange(&execution); changeErr != nil {
+				return &execution, changeErr
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return &execution, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// CancelCompile marks the execution as cancelled if it has not yet completed,
+// the loader will skip it if queued or kill the container if running.
+func (s Server) CancelCompile(ctx context.Context, in *consumerv1.CancelCompileRequest) (*consumerv1.CancelCompileResponse, error) {
+	parsedIDValue, err := uuid.Parse(in.GetId())
+
+	if err != nil {
+		return nil, invalidIDError("id")
+	}
+
+	if _, getErr := s.getExecution(ctx, parsedIDValue.String()); getErr != nil {
+		return nil, getErr
+	}
+
+	cancelled, err := s.Repo.CancelExecution(parsedIDValue.String(), sandbox.Cancelled.String(), inFlightStatuses)
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to cancel execution")
+		return nil, status.Error(codes.Internal, "failed to cancel execution")
+	}
+
+	if !cancelled {
+		return nil, status.Error(codes.FailedPrecondition, "the execution has already completed and cannot be cancelled")
+	}
+
+	auditLog(ctx).Str("id", parsedIDValue.String()).Msg("cancelled execution")
+
+	return &consumerv1.CancelCompileResponse{
+		Status: sandbox.Cancelled.String(),
+	}, nil
+}
+
+// ListExecutions returns a single page of executions matching the filter. One
+// more execution than requested is loaded to determine if a next page exists.
+func (s Server) ListExecutions(ctx context.Context, in *consumerv1.ListExecutionsRequest) (*consumerv1.ListExecutionsResponse, error) {
+	after, err := decodePageToken(in.GetPageToken())
+
+	if err != nil {
+		return nil, validation.NewError("page_token", "failed to parse page token")
+	}
+
+	pageSize := int(in.GetPageSize())
+
+	if pageSize == 0 {
+		pageSize = defaultListPageSize
 	}
 
+	filter := &repository.ExecutionFilter{
+		TenantID:   auth.TenantID(ctx),
+		Language:   in.GetLanguage(),
+		Status:     in.GetStatus(),
+		TestStatus: in.GetTestStatus(),
+		After:      after,
+		Limit:      pageSize + 1,
+	}
+
+	if in.CreatedAfter != nil {
+		createdAfter := in.CreatedAfter.AsTime()
+		filter.CreatedAfter = &createdAfter
+	}
+
+	if in.CreatedBefore != nil {
+		createdBefore := in.CreatedBefore.AsTime()
+		filter.CreatedBefore = &createdBefore
+	}
+
+	executions, err := s.Repo.ListExecutions(filter)
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list executions")
+		return nil, status.Error(codes.Internal, "failed to list executions")
+	}
+
+	resp := &consumerv1.ListExecutionsResponse{
+		Executions: make([]*consumerv1.ExecutionSummary, 0, pageSize),
+	}
+
+	if len(executions) > pageSize {
+		executions = executions[:pageSize]
+		last := executions[len(executions)-1]
+
+		resp.NextPageToken = encodePageToken(&repository.ExecutionCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
+	}
+
+	for i := range executions {
+		resp.Executions = append(resp.Executions, &consumerv1.ExecutionSummary{
+			Id:              executions[i].ID,
+			Language:        executions[i].Language,
+			Status:          executions[i].Status,
+			TestStatus:      executions[i].TestStatus,
+			CompileMs:       executions[i].CompileMs,
+			RuntimeMs:       executions[i].RuntimeMs,
+			RuntimeMemoryMb: executions[i].RuntimeMemoryMb,
+			CreatedAt:       timestamppb.New(executions[i].CreatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// getCompileTestCases returns the test cases of the request, falling back to
+// the single standard input and expected output if no test cases are provided.
+func getCompileTestCases(in *consumerv1.CreateCompileRequest) []queue.CompileTestCase {
+	if len(in.TestCases) == 0 {
+		if len(in.StandardInData) == 0 && len(in.ExpectedStandardOutData) == 0 {
+			return nil
+		}
+
+		return []queue.CompileTestCase{{
+			StdinData:          in.StandardInData,
+			ExpectedStdoutData: in.ExpectedStandardOutData,
+		}}
+	}
+
+	testCases := make([]queue.CompileTestCase, 0, len(in.TestCases))
+
+	for _, testCase := range in.TestCases {
+		testCases = append(testCases, queue.CompileTestCase{
+			StdinData:          testCase.StandardInData,
+			ExpectedStdoutData: testCase.ExpectedStandardOutData,
+		})
+	}
+
+	return testCases
+}
+
+// getCompileResultResponse builds the complete compile result for the given
+// execution, including the output files written by the loader.
+func (s Server) getCompileResultResponse(execution *repository.Execution) *consumerv1.GetCompileResultResponse {
 	resp := &consumerv1.GetCompileResultResponse{
 		Status:          execution.Status,
 		TestStatus:      execution.TestStatus,
@@ -59,72 +345,759 @@ func (s Server) GetCompileResultRequest(_ context.Context, in *consumerv1.GetCom
 
 	compiler := sandbox.Compilers[execution.Language]
 
-	if data, outputErr := s.FileHandler.GetFile(parsedIDValue.String(), compiler.OutputFile); outputErr == nil {
+	if data, outputErr := s.FileHandler.GetFile(execution.ID, compiler.OutputFile); outputErr == nil {
 		log.Debug().Str("data", string(data)).Msg("data")
 		resp.Output = string(data)
 	}
 
-	if data, outputErr := s.FileHandler.GetFile(parsedIDValue.String(), compiler.OutputErrFile); outputErr == nil {
+	if data, outputErr := s.FileHandler.GetFile(execution.ID, compiler.OutputErrFile); outputErr == nil {
 		log.Debug().Str("data", string(data)).Msg("data")
 		resp.OutputError = string(data)
 	}
 
-	if data, outputErr := s.FileHandler.GetFile(parsedIDValue.String(), compiler.CompilerOutputFile); outputErr == nil {
+	if data, outputErr := s.FileHandler.GetFile(execution.ID, compiler.CompilerOutputFile); outputErr == nil {
 		log.Debug().Str("data", string(data)).Msg("data")
 		resp.CompilerOutput = string(data)
 	}
 
-	return resp, nil
+	if testCases, err := s.Repo.GetExecutionTestCases(execution.ID); err == nil {
+		for _, testCase := range testCases {
+			resp.TestCases = append(resp.TestCases, &consumerv1.TestCaseResult{
+				Status:          testCase.Status,
+				TestStatus:      testCase.TestStatus,
+				RuntimeMs:       testCase.RuntimeMs,
+				RuntimeMemoryMb: testCase.RuntimeMemoryMb,
+				Score:           testCase.Score,
+				CheckerMessage:  testCase.CheckerMessage,
+
+				InteractorRuntimeMs:       testCase.InteractorRuntimeMs,
+				InteractorRuntimeMemoryMb: testCase.InteractorRuntimeMemoryMb,
+
+				Diff: getOutputDiff(testCase.Diff),
+			})
+		}
+	}
+
+	resp.Score = execution.Score
+	resp.MaxScore = execution.MaxScore
+
+	if testGroups, err := s.Repo.GetExecutionTestGroups(execution.ID); err == nil {
+		for _, group := range testGroups {
+			resp.TestGroups = append(resp.TestGroups, &consumerv1.TestGroupResult{
+				Name:       group.Name,
+				TestStatus: group.TestStatus,
+				Score:      group.Score,
+				Weight:     group.Weight,
+			})
+		}
+	}
+
+	return resp
 }
 
-func (s Server) CreateCompileRequest(_ context.Context, direct *consumerv1.CreateCompileRequest) (*consumerv1.CreateCompileResponse, error) {
-	compiler := sandbox.Compilers[direct.Language]
-	requestID := uuid.NewString()
+func (s Server) CreateCompile(ctx context.Context, direct *consumerv1.CreateCompileRequest) (*consumerv1.CreateCompileResponse, error) {
+	requestID, err := s.createCompile(ctx, direct)
 
-	_ = s.FileHandler.WriteFile(&files.File{
-		ID:   requestID,
-		Name: compiler.SourceFile,
-		Data: []byte(direct.Source),
-	})
+	if err != nil {
+		return nil, err
+	}
 
-	bytes, _ := json.Marshal(queue.CompileMessage{
-		ID:                 requestID,
-		Language:           direct.Language,
-		StdinData:          direct.StandardInData,
-		ExpectedStdoutData: direct.ExpectedStandardOutData,
-	})
+	return &consumerv1.CreateCompileResponse{
+		Id: requestID,
+	}, nil
+}
 
-	err := s.Queue.SubmitMessageToQueue(bytes)
+// CompileAndWait enqueues the compile request the same as CreateCompile and
+// then blocks until the execution reaches a terminal status. If the deadline
+// of the caller is reached first, the id and current status are returned to
+// allow the caller to fall back to polling.
+func (s Server) CompileAndWait(ctx context.Context, in *consumerv1.CompileAndWaitRequest) (*consumerv1.CompileAndWaitResponse, error) {
+	requestID, err := s.createCompile(ctx, in.GetRequest())
 
 	if err != nil {
-		log.Error().Err(err)
-		return nil, fmt.Errorf("failed to execute compile request")
+		return nil, err
 	}
 
-	dbErr := s.Repo.InsertExecution(&repository.Execution{
-		ID:         requestID,
-		Language:   direct.Language,
-		Status:     sandbox.NotRan.String(),
-		TestStatus: sandbox.TestNotRan.String(),
+	// the response has to be returned before the deadline of the caller
+	// otherwise it will never be received, so stop waiting slightly early.
+	var cancel context.CancelFunc
+
+	if deadline, ok := ctx.Deadline(); ok {
+		ctx, cancel = context.WithDeadline(ctx, deadline.Add(-compileAndWaitDeadlineMargin))
+	} else {
+		ctx, cancel = context.WithTimeout(ctx, compileAndWaitMaxDuration)
+	}
+
+	defer cancel()
+
+	execution, err := s.watchExecution(ctx, requestID, func(*repository.Execution) error {
+		return nil
 	})
 
-	if dbErr != nil {
-		log.Error().Err(dbErr).Msg("failed to create execution record")
-		return nil, fmt.Errorf("failed to create execution record")
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &consumerv1.CompileAndWaitResponse{
+			Id:     requestID,
+			Status: execution.Status,
+		}, nil
 	}
 
-	return &consumerv1.CreateCompileResponse{
-		Id: requestID,
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, status.FromContextError(ctxErr).Err()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &consumerv1.CompileAndWaitResponse{
+		Id:        requestID,
+		Status:    execution.Status,
+		Completed: true,
+		Result:    s.getCompileResultResponse(execution),
 	}, nil
 }
 
+// createCompile creates the execution record, writes the source code and
+// enqueues the compile request, returning the id of the execution. The record
+// is created first so it always exists once the loader receives the request.
+func (s Server) createCompile(ctx context.Context, direct *consumerv1.CreateCompileRequest) (string, error) {
+	tenantID := auth.TenantID(ctx)
+
+	compileMsg, sourceFiles, err := s.prepareCompileMessage(tenantID, direct)
+
+	if err != nil {
+		return "", err
+	}
+
+	var idempotencyKey *repository.IdempotencyKey
+
+	// if the request has already been made with the same key then the original
+	// execution is returned, without writing the source or queueing again. The
+	// original execution is only returned once it has been created, since an
+	// in progress claim is released if creating the execution fails.
+	if direct.IdempotencyKey != "" {
+		idempotencyKey = &repository.IdempotencyKey{
+			Scope:       tenantID,
+			Key:         direct.IdempotencyKey,
+			ExecutionID: compileMsg.ID,
+		}
+
+		now := time.Now()
+		claim, claimErr := s.Repo.ClaimIdempotencyKey(idempotencyKey,
+			now.Add(-idempotencyKeyRetention), now.Add(-idempotencyClaimTimeout))
+
+		if claimErr != nil {
+			log.Error().Err(claimErr).Msg("failed to claim idempotency key")
+			return "", status.Error(codes.Internal, "failed to claim idempotency key")
+		}
+
+		if claim.ExecutionID != compileMsg.ID && !claim.Completed {
+			return "", status.Error(codes.Aborted, "a request with the same idempotency key is in progress, retry the request")
+		}
+
+		if claim.ExecutionID != compileMsg.ID {
+			return claim.ExecutionID, nil
+		}
+	}
+
+	if err := s.insertExecution(ctx, newExecution(compileMsg, tenantID)); err != nil {
+		s.releaseIdempotencyKey(idempotencyKey)
+		return "", err
+	}
+
+	if errs := s.FileHandler.WriteFiles(sourceFiles...); len(errs) > 0 {
+		log.Error().Errs("errors", errs).Msg("failed to write source file")
+		s.failExecutions(compileMsg.ID)
+		s.releaseIdempotencyKey(idempotencyKey)
+		return "", status.Error(codes.Unavailable, "failed to write source file")
+	}
+
+	bytes, _ := json.Marshal(compileMsg)
+
+	if err := s.Queue.SubmitMessageToQueue(bytes); err != nil {
+		log.Error().Err(err).Msg("failed to submit compile request to queue")
+		s.failExecutions(compileMsg.ID)
+		s.releaseIdempotencyKey(idempotencyKey)
+		return "", status.Error(codes.Unavailable, "failed to execute compile request")
+	}
+
+	if idempotencyKey != nil {
+		if err := s.Repo.CompleteIdempotencyKey(idempotencyKey); err != nil {
+			log.Error().Err(err).Msg("failed to complete idempotency key")
+		}
+	}
+
+	auditLog(ctx).Str("id", compileMsg.ID).Msg("created execution")
+
+	return compileMsg.ID, nil
+}
+
+// inFlightLimit returns the in-flight execution limit of the tenant, or nil if
+// the tenant is not limited.
+func (s Server) inFlightLimit(tenantID string) *repository.InFlightLimit {
+	if s.Limiter == nil {
+		return nil
+	}
+
+	return &repository.InFlightLimit{
+		TenantID: tenantID,
+		Statuses: inFlightStatuses,
+		Max:      s.Limiter.Limits(tenantID).MaxInFlightExecutions,
+	}
+}
+
+// insertExecution creates the execution record, returning a resource exhausted
+// error if the execution would exceed the in-flight limit of the tenant.
+func (s Server) insertExecution(ctx context.Context, execution *repository.Execution) error {
+	limit := s.inFlightLimit(execution.TenantID)
+	err := s.Repo.InsertExecution(execution, limit)
+
+	if errors.Is(err, repository.ErrInFlightLimitReached) {
+		return inFlightLimitError(ctx, limit)
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create execution record")
+		return status.Error(codes.Internal, "failed to create execution record")
+	}
+
+	return nil
+}
+
+// inFlightLimitError returns the resource exhausted error of the limit.
+func inFlightLimitError(ctx context.Context, limit *repository.InFlightLimit) error {
+	message := fmt.Sprintf("in-flight execution limit of %d reached", limit.Max)
+	return ratelimit.ResourceExhausted(ctx, message, inFlightRetryAfter)
+}
+
+// failExecutions marks the executions which were created but never queued as
+// failed, so they no longer count towards the in-flight limit.
+func (s Server) failExecutions(ids ...string) {
+	if err := s.Repo.UpdateExecutionsStatus(ids, sandbox.NonDeterministicError.String()); err != nil {
+		log.Error().Err(err).Strs("ids", ids).Msg("failed to mark unqueued executions as failed")
+	}
+}
+
+// releaseIdempotencyKey releases the claimed key, if any, allowing the failed
+// request to be retried with the same key.
+func (s Server) releaseIdempotencyKey(key *repository.IdempotencyKey) {
+	if key == nil {
+		return
+	}
+
+	if err := s.Repo.ReleaseIdempotencyKey(key); err != nil {
+		log.Error().Err(err).Msg("failed to release idempotency key")
+	}
+}
+
+// prepareCompileMessage resolves the uploaded source of the request, if any,
+// before building the compile message of a new execution.
+func (s Server) prepareCompileMessage(tenantID string, direct *consumerv1.CreateCompileRequest) (*queue.CompileMessage, []*files.File, error) {
+	uploaded, err := s.getUploadedSource(tenantID, direct.SourceId)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	compileMsg, sourceFiles, err := newCompileMessage(direct, uploaded)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if compileMsg.CallbackURL != "" {
+		if err := webhook.ValidateURL(compileMsg.CallbackURL, s.AllowPrivateCallbacks); err != nil {
+			return nil, nil, validation.NewError("callback_url", err.Error())
+		}
+	}
+
+	checkerFile, err := newCheckerFile(compileMsg, direct.Checker)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if checkerFile != nil {
+		sourceFiles = append(sourceFiles, checkerFile)
+	}
+
+	interactorFile, err := newInteractorFile(compileMsg, direct.Interactor)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if interactorFile != nil {
+		sourceFiles = append(sourceFiles, interactorFile)
+	}
+
+	return compileMsg, sourceFiles, nil
+}
+
+// newCheckerFile sets the checker of the compile message, returning the file
+// of the checker source. No file is returned if the request has no checker.
+func newCheckerFile(compileMsg *queue.CompileMessage, checker *consumerv1.Checker) (*files.File, error) {
+	if checker == nil {
+		return nil, nil
+	}
+
+	if len(compileMsg.TestCases) == 0 {
+		return nil, validation.NewError("checker", "a checker requires at least one test case")
+	}
+
+	compileMsg.Checker = &queue.CompileChecker{Language: checker.Language}
+
+	return &files.File{
+		ID:   compileMsg.ID,
+		Name: sandbox.CheckerFileName(sandbox.Compilers[checker.Language].SourceFile),
+		Data: []byte(checker.Source),
+	}, nil
+}
+
+// newInteractorFile sets the interactor of the compile message, returning the
+// file of the interactor source. No file is returned if the request has no
+// interactor.
+func newInteractorFile(compileMsg *queue.CompileMessage, interactor *consumerv1.Interactor) (*files.File, error) {
+	if interactor == nil {
+		return nil, nil
+	}
+
+	if len(compileMsg.TestCases) == 0 {
+		return nil, validation.NewError("interactor", "an interactor requires at least one test case")
+	}
+
+	if compileMsg.Checker != nil {
+		return nil, validation.NewError("interactor", "the interactor cannot be provided alongside the checker")
+	}
+
+	compiler := sandbox.Compilers[interactor.Language]
+
+	if err := sandbox.Compilers[compileMsg.Language].ValidateInteractor(compiler); err != nil {
+		return nil, validation.NewError("interactor.language", err.Error())
+	}
+
+	compileMsg.Interactor = &queue.CompileInteractor{Language: interactor.Language}
+
+	return &files.File{
+		ID:   compileMsg.ID,
+		Name: sandbox.InteractorFileName(compiler.SourceFile),
+		Data: []byte(interactor.Source),
+	}, nil
+}
+
+// newCompileMessage validates the request and builds the compile message and
+// the source files of a new execution. The files of an uploaded source are
+// already stored, so no source files are returned when compiling one.
+func newCompileMessage(direct *consumerv1.CreateCompileRequest, uploaded *repository.Source) (*queue.CompileMessage, []*files.File, error) {
+	compileMsg := &queue.CompileMessage{
+		ID:                 uuid.NewString(),
+		Language:           direct.Language,
+		TestCases:          getCompileTestCases(direct),
+		TimeLimitMs:        int64(direct.TimeLimitMs),
+		CompileTimeLimitMs: int64(direct.CompileTimeLimitMs),
+		MemoryLimitMb:      int64(direct.MemoryLimitMb),
+		CallbackURL:        direct.CallbackUrl,
+		IncludeBinary:      direct.IncludeBinary,
+		IncludeDiff:        direct.IncludeDiff,
+	}
+
+	comparator, err := getComparator(direct.Comparison)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	compileMsg.Comparator = comparator
+
+	testGroups, err := getTestGroups(direct)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	compileMsg.TestGroups = testGroups
+
+	if err := validateLimits(compileMsg.Limits()); err != nil {
+		return nil, nil, err
+	}
+
+	compiler := sandbox.Compilers[direct.Language]
+
+	if compileMsg.IncludeBinary && !compiler.SupportsBinary() {
+		return nil, nil, validation.NewError("include_binary", fmt.Sprintf("collecting the binary is not supported for %s", direct.Language))
+	}
+
+	if uploaded != nil {
+		if direct.Source != "" || len(direct.Files) > 0 {
+			return nil, nil, validation.NewError("source_id", "the source id cannot be provided alongside the source or the files")
+		}
+
+		if err := validateSourceFiles(compiler, direct.Language, uploaded.Files, direct.EntryFile); err != nil {
+			return nil, nil, err
+		}
+
+		compileMsg.SourceID = uploaded.ID
+		compileMsg.Files = uploaded.Files
+		compileMsg.EntryFile = entryFileOrDefault(compiler, direct.EntryFile)
+
+		return compileMsg, nil, nil
+	}
+
+	if len(direct.Files) == 0 {
+		if direct.Source == "" {
+			return nil, nil, validation.NewError("source", "either the source or the files must be provided")
+		}
+
+		return compileMsg, []*files.File{{
+			ID:   compileMsg.ID,
+			Name: compiler.SourceFile,
+			Data: []byte(direct.Source),
+		}}, nil
+	}
+
+	if direct.Source != "" {
+		return nil, nil, validation.NewError("source", "the source cannot be provided alongside the files")
+	}
+
+	paths := make([]string, 0, len(direct.Files))
+
+	for _, file := range direct.Files {
+		paths = append(paths, file.Path)
+	}
+
+	if err := validateSourceFiles(compiler, direct.Language, paths, direct.EntryFile); err != nil {
+		return nil, nil, err
+	}
+
+	compileMsg.Files = paths
+	compileMsg.EntryFile = entryFileOrDefault(compiler, direct.EntryFile)
+
+	sourceFiles := make([]*files.File, 0, len(direct.Files))
+
+	for _, file := range direct.Files {
+		sourceFiles = append(sourceFiles, &files.File{
+			ID:   compileMsg.ID,
+			Name: sandbox.ProjectFileName(file.Path),
+			Data: []byte(file.Content),
+		})
+	}
+
+	return compileMsg, sourceFiles, nil
+}
+
+// comparisonModes maps the comparison modes of the api to the comparator modes
+// of the sandbox.
+var comparisonModes = map[consumerv1.ComparisonMode]sandbox.ComparatorMode{
+	consumerv1.ComparisonMode_COMPARISON_MODE_UNSPECIFIED:                sandbox.CompareExact,
+	consumerv1.ComparisonMode_COMPARISON_MODE_EXACT:                      sandbox.CompareExact,
+	consumerv1.ComparisonMode_COMPARISON_MODE_IGNORE_TRAILING_WHITESPACE: sandbox.CompareIgnoreTrailingWhitespace,
+	consumerv1.ComparisonMode_COMPARISON_MODE_TOKENS:                     sandbox.CompareTokens,
+	consumerv1.ComparisonMode_COMPARISON_MODE_CASE_INSENSITIVE:           sandbox.CompareCaseInsensitive,
+	consumerv1.ComparisonMode_COMPARISON_MODE_FLOAT:                      sandbox.CompareFloat,
+	consumerv1.ComparisonMode_COMPARISON_MODE_UNORDERED_LINES:            sandbox.CompareUnorderedLines,
+}
+
+// scoringPolicies maps the scoring policies of the api to the scoring policies
+// of the sandbox.
+var scoringPolicies = map[consumerv1.ScoringPolicy]sandbox.ScoringPolicy{
+	consumerv1.ScoringPolicy_SCORING_POLICY_UNSPECIFIED:    sandbox.ScoreAllOrNothing,
+	consumerv1.ScoringPolicy_SCORING_POLICY_ALL_OR_NOTHING: sandbox.ScoreAllOrNothing,
+	consumerv1.ScoringPolicy_SCORING_POLICY_PROPORTIONAL:   sandbox.ScoreProportional,
+	consumerv1.ScoringPolicy_SCORING_POLICY_MIN:            sandbox.ScoreMinimum,
+}
+
+// getTestGroups returns the groups of the request with the indexes of the
+// test cases of each group, or nil if no groups were provided. Every test case
+// must name one of the groups and each group may only depend on the groups
+// declared before it.
+func getTestGroups(in *consumerv1.CreateCompileRequest) ([]*sandbox.TestGroup, error) {
+	validationErr := &validation.Error{}
+
+	if len(in.TestGroups) == 0 {
+		for i, testCase := range in.TestCases {
+			if testCase.Group != "" {
+				validationErr.Violations = append(validationErr.Violations, validation.Violation{
+					Field:       fmt.Sprintf("test_cases[%d].group", i),
+					Description: "the group cannot be provided without test groups",
+				})
+			}
+		}
+
+		if len(validationErr.Violations) > 0 {
+			return nil, validationErr
+		}
+
+		return nil, nil
+	}
+
+	if len(in.TestCases) == 0 {
+		return nil, validation.NewError("test_groups", "test groups require test cases")
+	}
+
+	groups := make([]*sandbox.TestGroup, 0, len(in.TestGroups))
+	declared := make(map[string]*sandbox.TestGroup, len(in.TestGroups))
+
+	for i, testGroup := range in.TestGroups {
+		if declared[testGroup.Name] != nil {
+			validationErr.Violations = append(validationErr.Violations, validation.Violation{
+				Field:       fmt.Sprintf("test_groups[%d].name", i),
+				Description: fmt.Sprintf("duplicate group %q", testGroup.Name),
+			})
+
+			continue
+		}
+
+		for j, dependency := range testGroup.Dependencies {
+			if declared[dependency] == nil {
+				validationErr.Violations = append(validationErr.Violations, validation.Violation{
+					Field:       fmt.Sprintf("test_groups[%d].dependencies[%d]", i, j),
+					Description: fmt.Sprintf("group %q must be declared before the groups depending on it", dependency),
+				})
+			}
+		}
+
+		// the proto rule accepts NaN and infinity, either would leave the
+		// score of the whole execution undefined.
+		if math.IsNaN(testGroup.Weight) || math.IsInf(testGroup.Weight, 0) {
+			validationErr.Violations = append(validationErr.Violations, validation.Violation{
+				Field:       fmt.Sprintf("test_groups[%d].weight", i),
+				Description: "the weight must be a finite number",
+			})
+		}
+
+		group := &sandbox.TestGroup{
+			Name:         testGroup.Name,
+			Weight:       testGroup.Weight,
+			Policy:       scoringPolicies[testGroup.Policy],
+			Dependencies: testGroup.Dependencies,
+		}
+
+		groups = append(groups, group)
+		declared[group.Name] = group
+	}
+
+	for i, testCase := range in.TestCases {
+		group := declared[testCase.Group]
+
+		if group == nil {
+			description := fmt.Sprintf("group %q is not one of the test groups", testCase.Group)
+
+			if testCase.Group == "" {
+				description = "the group is required when test groups are provided"
+			}
+
+			validationErr.Violations = append(validationErr.Violations, validation.Violation{
+				Field:       fmt.Sprintf("test_cases[%d].group", i),
+				Description: description,
+			})
+
+			continue
+		}
+
+		group.Tests = append(group.Tests, i)
+	}
+
+	// the groups only match the indexes of the requested groups when none of
+	// the groups were skipped as duplicates.
+	for i, group := range groups {
+		if len(group.Tests) == 0 && len(validationErr.Violations) == 0 {
+			validationErr.Violations = append(validationErr.Violations, validation.Violation{
+				Field:       fmt.Sprintf("test_groups[%d]", i),
+				Description: fmt.Sprintf("group %q has no test cases", group.Name),
+			})
+		}
+	}
+
+	if len(validationErr.Violations) > 0 {
+		return nil, validationErr
+	}
+
+	return groups, nil
+}
+
+// getOutputDiff returns the diff of a failed test case, or nil if the test
+// case did not fail.
+func getOutputDiff(diff *repository.ExecutionTestCaseDiff) *consumerv1.OutputDiff {
+	if diff == nil {
+		return nil
+	}
+
+	return &consumerv1.OutputDiff{
+		Line:          int32(diff.Line),
+		Column:        int32(diff.Column),
+		Token:         int32(diff.Token),
+		Expected:      diff.Expected,
+		Actual:        diff.Actual,
+		ExpectedLines: int32(diff.ExpectedLines),
+		ActualLines:   int32(diff.ActualLines),
+		UnifiedDiff:   diff.UnifiedDiff,
+	}
+}
+
+// getComparator returns the comparator of the requested comparison, or nil if
+// the output should be compared exactly.
+func getComparator(comparison *consumerv1.OutputComparison) (*sandbox.Comparator, error) {
+	if comparison == nil {
+		return nil, nil
+	}
+
+	comparator := &sandbox.Comparator{
+		Mode:            comparisonModes[comparison.Mode],
+		AbsoluteEpsilon: comparison.AbsoluteEpsilon,
+		RelativeEpsilon: comparison.RelativeEpsilon,
+	}
+
+	if comparator.Mode == sandbox.CompareFloat && comparator.AbsoluteEpsilon == 0 && comparator.RelativeEpsilon == 0 {
+		return nil, validation.NewError("comparison", "an absolute or relative epsilon is required when comparing floats")
+	}
+
+	return comparator, nil
+}
+
+// entryFileOrDefault returns the entry file, falling back to the source file
+// of the language if not provided.
+func entryFileOrDefault(compiler *sandbox.LanguageCompiler, entryFile string) string {
+	if entryFile == "" {
+		return compiler.SourceFile
+	}
+
+	return entryFile
+}
+
+// validateSourceFiles validates the paths of the files of a multi-file
+// submission, each path must be unique and stay within the project directory,
+// and the entry file must be one of the submitted files.
+func validateSourceFiles(compiler *sandbox.LanguageCompiler, language string, filePaths []string, entryFile string) error {
+	if !compiler.SupportsProjects() {
+		return validation.NewError("files", fmt.Sprintf("multi-file submissions are not supported for %s", language))
+	}
+
+	validationErr := &validation.Error{}
+	paths := make(map[string]bool, len(filePaths))
+
+	for i, filePath := range filePaths {
+		field := fmt.Sprintf("files[%d].path", i)
+
+		if err := sandbox.ValidateSourcePath(filePath); err != nil {
+			validationErr.Violations = append(validationErr.Violations, validation.Violation{
+				Field:       field,
+				Description: err.Error(),
+			})
+
+			continue
+		}
+
+		if paths[filePath] {
+			validationErr.Violations = append(validationErr.Violations, validation.Violation{
+				Field:       field,
+				Description: fmt.Sprintf("duplicate path %q", filePath),
+			})
+		}
+
+		paths[filePath] = true
+	}
+
+	entryFile = entryFileOrDefault(compiler, entryFile)
+
+	if err := compiler.ValidateEntryFile(entryFile); err != nil {
+		validationErr.Violations = append(validationErr.Violations, validation.Violation{
+			Field:       "entry_file",
+			Description: err.Error(),
+		})
+	} else if !paths[entryFile] {
+		validationErr.Violations = append(validationErr.Violations, validation.Violation{
+			Field:       "entry_file",
+			Description: fmt.Sprintf("entry file %q is not one of the submitted files", entryFile),
+		})
+	}
+
+	if len(validationErr.Violations) > 0 {
+		return validationErr
+	}
+
+	return nil
+}
+
+// validateLimits validates each of the requested limits against the maximums
+// of the machine profile, reporting every limit exceeding its maximum.
+func validateLimits(limits *sandbox.Limits) error {
+	profile := sandbox.GetProfileForMachine()
+
+	fields := []struct {
+		name   string
+		limits *sandbox.Limits
+	}{
+		{name: "time_limit_ms", limits: &sandbox.Limits{CodeTimeout: limits.CodeTimeout}},
+		{name: "compile_time_limit_ms", limits: &sandbox.Limits{CompileTimeout: limits.CompileTimeout}},
+		{name: "memory_limit_mb", limits: &sandbox.Limits{ExecutionMemory: limits.ExecutionMemory}},
+	}
+
+	validationErr := &validation.Error{}
+
+	for _, field := range fields {
+		if err := profile.ValidateLimits(field.limits); err != nil {
+			validationErr.Violations = append(validationErr.Violations, validation.Violation{
+				Field:       field.name,
+				Description: err.Error(),
+			})
+		}
+	}
+
+	if len(validationErr.Violations) > 0 {
+		return validationErr
+	}
+
+	return nil
+}
+
+// newExecution returns the initial execution record of the compile message.
+func newExecution(compileMsg *queue.CompileMessage, tenantID string) *repository.Execution {
+	return &repository.Execution{
+		ID:         compileMsg.ID,
+		TenantID:   tenantID,
+		Language:   compileMsg.Language,
+		Status:     sandbox.NotRan.String(),
+		TestStatus: sandbox.TestNotRan.String(),
+	}
+}
+
 func (s Server) GetSupportedLanguages(_ context.Context, _ *emptypb.Empty) (*consumerv1.GetSupportedLanguagesResponse, error) {
 	supported := make([]*consumerv1.SupportedLanguage, 0, len(sandbox.Compilers))
+	limits := getLanguageLimits(sandbox.GetProfileForMachine())
+
+	// the languages are still returned without their versions and availability
+	// if the statuses cannot be read, since the remaining details are static.
+	statuses, err := s.Repo.GetLanguageStatuses()
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get language statuses")
+	}
+
+	languageStatuses := make(map[string]repository.LanguageStatus, len(statuses))
+
+	for _, languageStatus := range statuses {
+		languageStatuses[languageStatus.Language] = languageStatus
+	}
 
 	for langCode, compiler := range sandbox.Compilers {
+		_, templateAvailable := sandbox.GetTemplate(langCode, sandbox.DefaultTemplateVariant)
+
 		supportedLang := &consumerv1.SupportedLanguage{
-			LanguageCode: langCode,
-			DisplayName:  compiler.Language,
+			LanguageCode:      langCode,
+			DisplayName:       compiler.Language,
+			CompileCommands:   compiler.CompileCommands(),
+			RunCommand:        compiler.RunCommand(),
+			SourceFile:        compiler.SourceFile,
+			Interpreted:       compiler.Interpreter,
+			Limits:            limits,
+			TemplateAvailable: templateAvailable,
+		}
+
+		if languageStatus, ok := languageStatuses[langCode]; ok {
+			supportedLang.Version = languageStatus.Version
+			supportedLang.CheckedAt = timestamppb.New(languageStatus.CheckedAt)
+			supportedLang.Available = languageStatus.Available &&
+				time.Since(languageStatus.CheckedAt) < languageStatusStaleAfter
 		}
 
 		if compiler.Compiler != "" && !strings.EqualFold(compiler.Compiler, langCode) {
@@ -144,14 +1117,49 @@ func (s Server) GetSupportedLanguages(_ context.Context, _ *emptypb.Empty) (*con
 
 }
 
+// getLanguageLimits returns the default and maximum limits of the profile.
+func getLanguageLimits(profile *sandbox.Profile) *consumerv1.LanguageLimits {
+	return &consumerv1.LanguageLimits{
+		TimeLimitMs:           uint32(profile.CodeTimeout.Milliseconds()),
+		CompileTimeLimitMs:    uint32(profile.CompileTimeout.Milliseconds()),
+		MemoryLimitMb:         uint32(profile.ExecutionMemory.Megabytes()),
+		MaxTimeLimitMs:        uint32(profile.MaxCodeTimeout.Milliseconds()),
+		MaxCompileTimeLimitMs: uint32(profile.MaxCompileTimeout.Milliseconds()),
+		MaxMemoryLimitMb:      uint32(profile.MaxExecutionMemory.Megabytes()),
+	}
+}
+
 func (s Server) GetTemplate(_ context.Context, in *consumerv1.GetTemplateRequest) (*consumerv1.GetTemplateResponse, error) {
-	if template, ok := sandbox.CompilerTemplate[in.Language]; ok {
+	if template, ok := sandbox.GetTemplate(in.Language, in.Variant); ok {
 		return &consumerv1.GetTemplateResponse{
 			Template: template,
 		}, nil
 	}
 
-	return nil, fmt.Errorf("template for langauge `%s` does not exist", in.Language)
+	if in.Variant != "" {
+		return nil, status.Errorf(codes.NotFound, "template variant `%s` for langauge `%s` does not exist", in.Variant, in.Language)
+	}
+
+	return nil, status.Errorf(codes.NotFound, "template for langauge `%s` does not exist", in.Language)
+}
+
+// ListTemplates returns every template variant, optionally only the variants
+// of the given language.
+func (s Server) ListTemplates(_ context.Context, in *consumerv1.ListTemplatesRequest) (*consumerv1.ListTemplatesResponse, error) {
+	templates := sandbox.ListTemplates(in.Language)
+
+	resp := &consumerv1.ListTemplatesResponse{
+		Templates: make([]*consumerv1.TemplateVariant, 0, len(templates)),
+	}
+
+	for _, template := range templates {
+		resp.Templates = append(resp.Templates, &consumerv1.TemplateVariant{
+			Language: template.Language,
+			Variant:  template.Variant,
+		})
+	}
+
+	return resp, nil
 }
 
 func (s Server) Ping(_ context.Context, _ *emptypb.Empty) (*consumerv1.PingResponse, error) {
@@ -0,0 +1,377 @@
+package consumer
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"compile-and-run-sandbox/internal/auth"
+	"compile-and-run-sandbox/internal/files"
+	consumerv1 "compile-and-run-sandbox/internal/gen/pb/content/consumer/v1"
+	"compile-and-run-sandbox/internal/queue"
+	"compile-and-run-sandbox/internal/repository"
+	"compile-and-run-sandbox/internal/sandbox"
+)
+
+// executionRepository records the executions and idempotency keys of the
+// requests, returning the claim of the key if one is provided.
+type executionRepository struct {
+	repository.Repository
+
+	claim      *repository.IdempotencyKey
+	executions []*repository.Execution
+	failed     []string
+	completed  []string
+	released   []string
+}
+
+func (r *executionRepository) ClaimIdempotencyKey(key *repository.IdempotencyKey, _ time.Time, _ time.Time) (repository.IdempotencyKey, error) {
+	if r.claim != nil {
+		return *r.claim, nil
+	}
+
+	return *key, nil
+}
+
+func (r *executionRepository) CompleteIdempotencyKey(key *repository.IdempotencyKey) error {
+	r.completed = append(r.completed, key.ExecutionID)
+	return nil
+}
+
+func (r *executionRepository) ReleaseIdempotencyKey(key *repository.IdempotencyKey) error {
+	r.released = append(r.released, key.ExecutionID)
+	return nil
+}
+
+func (r *executionRepository) InsertExecution(execution *repository.Execution, _ *repository.InFlightLimit) error {
+	r.executions = append(r.executions, execution)
+	return nil
+}
+
+func (r *executionRepository) InsertBatch(_ *repository.Batch, executions []*repository.Execution, _ *repository.InFlightLimit) error {
+	r.executions = append(r.executions, executions...)
+	return nil
+}
+
+func (r *executionRepository) UpdateExecutionsStatus(ids []string, _ string) error {
+	r.failed = append(r.failed, ids...)
+	return nil
+}
+
+type memoryFiles struct {
+	files.Files
+	written []*files.File
+}
+
+func (m *memoryFiles) WriteFiles(written ...*files.File) []error {
+	m.written = append(m.written, written...)
+	return nil
+}
+
+// recordingQueue records the submitted messages, failing the submission with
+// the error if one is provided.
+type recordingQueue struct {
+	queue.Queue
+	err      error
+	messages [][]byte
+}
+
+func (q *recordingQueue) SubmitMessageToQueue(data []byte) error {
+	return q.SubmitMessagesToQueue([][]byte{data})
+}
+
+func (q *recordingQueue) SubmitMessagesToQueue(data [][]byte) error {
+	if q.err != nil {
+		return q.err
+	}
+
+	q.messages = append(q.messages, data...)
+	return nil
+}
+
+// violationFields returns the fields of the bad request details of the error,
+// ensuring the error is returned to the caller as an invalid argument.
+func violationFields(t *testing.T, err error) []string {
+	t.Helper()
+
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code(), err)
+
+	var fields []string
+
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+
+	return fields
+}
+
+func TestGetTestGroups(t *testing.T) {
+	group := func(name string, weight float64, dependencies ...string) *consumerv1.TestGroup {
+		return &consumerv1.TestGroup{Name: name, Weight: weight, Dependencies: dependencies}
+	}
+
+	testCases := func(groups ...string) []*consumerv1.TestCase {
+		cases := make([]*consumerv1.TestCase, 0, len(groups))
+
+		for _, name := range groups {
+			cases = append(cases, &consumerv1.TestCase{Group: name})
+		}
+
+		return cases
+	}
+
+	tests := []struct {
+		name       string
+		request    *consumerv1.CreateCompileRequest
+		want       []*sandbox.TestGroup
+		wantFields []string
+	}{{
+		name:    "should return no groups without test groups",
+		request: &consumerv1.CreateCompileRequest{TestCases: testCases("")},
+	}, {
+		name: "should group the test cases in the declared order",
+		request: &consumerv1.CreateCompileRequest{
+			TestGroups: []*consumerv1.TestGroup{group("a", 40), group("b", 60, "a")},
+			TestCases:  testCases("a", "b", "a"),
+		},
+		want: []*sandbox.TestGroup{
+			{Name: "a", Weight: 40, Tests: []int{0, 2}},
+			{Name: "b", Weight: 60, Dependencies: []string{"a"}, Tests: []int{1}},
+		},
+	}, {
+		name:       "should reject a group without test groups",
+		request:    &consumerv1.CreateCompileRequest{TestCases: testCases("a")},
+		wantFields: []string{"test_cases[0].group"},
+	}, {
+		name:       "should reject test groups without test cases",
+		request:    &consumerv1.CreateCompileRequest{TestGroups: []*consumerv1.TestGroup{group("a", 1)}},
+		wantFields: []string{"test_groups"},
+	}, {
+		name: "should reject a dependency declared after the group",
+		request: &consumerv1.CreateCompileRequest{
+			TestGroups: []*consumerv1.TestGroup{group("a", 1, "b"), group("b", 1)},
+			TestCases:  testCases("a", "b"),
+		},
+		wantFields: []string{"test_groups[0].dependencies[0]"},
+	}, {
+		name: "should reject a duplicate group",
+		request: &consumerv1.CreateCompileRequest{
+			TestGroups: []*consumerv1.TestGroup{group("a", 1), group("a", 1)},
+			TestCases:  testCases("a"),
+		},
+		wantFields: []string{"test_groups[1].name"},
+	}, {
+		name: "should reject an unknown or missing group",
+		request: &consumerv1.CreateCompileRequest{
+			TestGroups: []*consumerv1.TestGroup{group("a", 1)},
+			TestCases:  testCases("a", "b", ""),
+		},
+		wantFields: []string{"test_cases[1].group", "test_cases[2].group"},
+	}, {
+		name: "should reject a group without test cases",
+		request: &consumerv1.CreateCompileRequest{
+			TestGroups: []*consumerv1.TestGroup{group("a", 1), group("b", 1)},
+			TestCases:  testCases("a"),
+		},
+		wantFields: []string{"test_groups[1]"},
+	}, {
+		name: "should reject non finite weights",
+		request: &consumerv1.CreateCompileRequest{
+			TestGroups: []*consumerv1.TestGroup{group("a", math.NaN()), group("b", math.Inf(1)), group("c", math.Inf(-1))},
+			TestCases:  testCases("a", "b", "c"),
+		},
+		wantFields: []string{"test_groups[0].weight", "test_groups[1].weight", "test_groups[2].weight"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := getTestGroups(tt.request)
+
+			if tt.wantFields != nil {
+				assert.Equal(t, tt.wantFields, violationFields(t, err))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, groups)
+		})
+	}
+}
+
+func TestValidateSourceFiles(t *testing.T) {
+	tests := []struct {
+		name       string
+		language   string
+		paths      []string
+		entryFile  string
+		wantFields []string
+	}{{
+		name:     "should accept the project files",
+		language: "c",
+		paths:    []string{"solution.c", "utils/math.c", "utils/math.h"},
+	}, {
+		name:      "should accept a declared entry file",
+		language:  "c",
+		paths:     []string{"app.c", "utils/math.c"},
+		entryFile: "app.c",
+	}, {
+		name:       "should reject a language without project support",
+		language:   "fsharp",
+		paths:      []string{"main.fs"},
+		wantFields: []string{"files"},
+	}, {
+		name:       "should reject paths leaving the project directory",
+		language:   "c",
+		paths:      []string{"solution.c", "../solution.c", "/etc/passwd", "utils/../../solution.c"},
+		wantFields: []string{"files[1].path", "files[2].path", "files[3].path"},
+	}, {
+		name:       "should reject duplicate paths",
+		language:   "c",
+		paths:      []string{"solution.c", "utils/math.c", "solution.c"},
+		wantFields: []string{"files[2].path"},
+	}, {
+		name:       "should reject an entry file which was not submitted",
+		language:   "c",
+		paths:      []string{"main.c", "utils/math.c"},
+		wantFields: []string{"entry_file"},
+	}, {
+		name:       "should reject an entry file of another language",
+		language:   "c",
+		paths:      []string{"solution.c", "utils/math.h"},
+		entryFile:  "utils/math.h",
+		wantFields: []string{"entry_file"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSourceFiles(sandbox.Compilers[tt.language], tt.language, tt.paths, tt.entryFile)
+
+			if tt.wantFields != nil {
+				assert.Equal(t, tt.wantFields, violationFields(t, err))
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetComparator(t *testing.T) {
+	tests := []struct {
+		name       string
+		comparison *consumerv1.OutputComparison
+		want       *sandbox.Comparator
+		wantFields []string
+	}{{
+		name: "should compare exactly without a comparison",
+	}, {
+		name:       "should map the comparison mode",
+		comparison: &consumerv1.OutputComparison{Mode: consumerv1.ComparisonMode_COMPARISON_MODE_TOKENS},
+		want:       &sandbox.Comparator{Mode: sandbox.CompareTokens},
+	}, {
+		name: "should accept floats with an absolute epsilon",
+		comparison: &consumerv1.OutputComparison{
+			Mode:            consumerv1.ComparisonMode_COMPARISON_MODE_FLOAT,
+			AbsoluteEpsilon: 1e-6,
+		},
+		want: &sandbox.Comparator{Mode: sandbox.CompareFloat, AbsoluteEpsilon: 1e-6},
+	}, {
+		name: "should accept floats with a relative epsilon",
+		comparison: &consumerv1.OutputComparison{
+			Mode:            consumerv1.ComparisonMode_COMPARISON_MODE_FLOAT,
+			RelativeEpsilon: 1e-9,
+		},
+		want: &sandbox.Comparator{Mode: sandbox.CompareFloat, RelativeEpsilon: 1e-9},
+	}, {
+		name:       "should reject floats without an epsilon",
+		comparison: &consumerv1.OutputComparison{Mode: consumerv1.ComparisonMode_COMPARISON_MODE_FLOAT},
+		wantFields: []string{"comparison"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comparator, err := getComparator(tt.comparison)
+
+			if tt.wantFields != nil {
+				assert.Equal(t, tt.wantFields, violationFields(t, err))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, comparator)
+		})
+	}
+}
+
+func TestCreateCompileIdempotency(t *testing.T) {
+	tests := []struct {
+		name         string
+		claim        *repository.IdempotencyKey
+		queueErr     error
+		wantID       string
+		wantCode     codes.Code
+		wantQueued   bool
+		wantComplete bool
+		wantReleased bool
+	}{{
+		name:         "should queue and complete a new claim",
+		wantQueued:   true,
+		wantComplete: true,
+	}, {
+		name:     "should abort while the original request is in progress",
+		claim:    &repository.IdempotencyKey{ExecutionID: "original"},
+		wantCode: codes.Aborted,
+	}, {
+		name:   "should return the original execution once completed",
+		claim:  &repository.IdempotencyKey{ExecutionID: "original", Completed: true},
+		wantID: "original",
+	}, {
+		name:         "should release the claim if the request fails to queue",
+		queueErr:     errors.New("queue unavailable"),
+		wantCode:     codes.Unavailable,
+		wantReleased: true,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &executionRepository{claim: tt.claim}
+			messages := &recordingQueue{err: tt.queueErr}
+
+			server := Server{Repo: repo, Queue: messages, FileHandler: &memoryFiles{}}
+			ctx := auth.NewContext(context.Background(), &auth.Tenant{ID: "tenant"})
+
+			id, err := server.createCompile(ctx, &consumerv1.CreateCompileRequest{
+				Language:       "python",
+				Source:         "print(1)",
+				IdempotencyKey: "key",
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err), err)
+			assert.Equal(t, tt.wantQueued, len(messages.messages) == 1)
+			assert.Equal(t, tt.wantComplete, len(repo.completed) == 1)
+			assert.Equal(t, tt.wantReleased, len(repo.released) == 1)
+
+			if tt.wantID != "" {
+				assert.Equal(t, tt.wantID, id)
+				assert.Empty(t, repo.executions)
+			}
+
+			if tt.wantReleased {
+				assert.Equal(t, repo.released, repo.failed)
+			}
+		})
+	}
+}
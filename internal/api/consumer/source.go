@@ -0,0 +1,147 @@
+package consumer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"compile-and-run-sandbox/internal/auth"
+	"compile-and-run-sandbox/internal/files"
+	consumerv1 "compile-and-run-sandbox/internal/gen/pb/content/consumer/v1"
+	"compile-and-run-sandbox/internal/repository"
+	"compile-and-run-sandbox/internal/sandbox"
+	"compile-and-run-sandbox/internal/source"
+	"compile-and-run-sandbox/internal/validation"
+)
+
+// sourceFormats maps the formats of the api to the formats of the source
+// extraction.
+var sourceFormats = map[consumerv1.SourceFormat]source.Format{
+	consumerv1.SourceFormat_SOURCE_FORMAT_PLAIN:  source.Plain,
+	consumerv1.SourceFormat_SOURCE_FORMAT_ZIP:    source.Zip,
+	consumerv1.SourceFormat_SOURCE_FORMAT_TAR_GZ: source.TarGz,
+}
+
+// UploadSource receives the chunks of the source until the client has closed
+// the stream, the source is then extracted and every file stored under the
+// id of the source. The size is checked as every chunk is received to avoid
+// buffering more than the limit allows.
+func (s Server) UploadSource(stream consumerv1.ConsumerService_UploadSourceServer) error {
+	first, err := stream.Recv()
+
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	metadata := first.GetMetadata()
+
+	if metadata == nil {
+		return validation.NewError("metadata", "the first message must contain the metadata")
+	}
+
+	format := sourceFormats[metadata.Format]
+
+	if format == source.Plain && metadata.Path == "" {
+		return validation.NewError("metadata.path", "the path is required for plain sources")
+	}
+
+	data := bytes.Buffer{}
+
+	for {
+		req, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if req.GetMetadata() != nil {
+			return validation.NewError("metadata", "the metadata must only be sent in the first message")
+		}
+
+		if int64(data.Len()+len(req.GetChunk())) > s.SourceLimits.MaxBytes {
+			return validation.NewError("chunk", fmt.Sprintf("the source exceeds the maximum size of %d bytes", s.SourceLimits.MaxBytes))
+		}
+
+		data.Write(req.GetChunk())
+	}
+
+	sourceFiles, err := source.Extract(format, metadata.Path, data.Bytes(), &s.SourceLimits)
+
+	if err != nil {
+		return validation.NewError("chunk", err.Error())
+	}
+
+	uploaded := &repository.Source{
+		ID:       uuid.NewString(),
+		TenantID: auth.TenantID(stream.Context()),
+		Files:    make([]string, 0, len(sourceFiles)),
+	}
+
+	storedFiles := make([]*files.File, 0, len(sourceFiles))
+
+	for _, sourceFile := range sourceFiles {
+		uploaded.Files = append(uploaded.Files, sourceFile.Path)
+		uploaded.SizeBytes += int64(len(sourceFile.Content))
+
+		storedFiles = append(storedFiles, &files.File{
+			ID:   uploaded.ID,
+			Name: sandbox.ProjectFileName(sourceFile.Path),
+			Data: []byte(sourceFile.Content),
+		})
+	}
+
+	if errs := s.FileHandler.WriteFiles(storedFiles...); len(errs) > 0 {
+		log.Error().Errs("errors", errs).Msg("failed to write uploaded source files")
+		return status.Error(codes.Unavailable, "failed to write source files")
+	}
+
+	if err := s.Repo.InsertSource(uploaded); err != nil {
+		log.Error().Err(err).Msg("failed to create source record")
+		return status.Error(codes.Internal, "failed to create source record")
+	}
+
+	auditLog(stream.Context()).
+		Str("source_id", uploaded.ID).
+		Int("files", len(uploaded.Files)).
+		Int64("size_bytes", uploaded.SizeBytes).
+		Msg("uploaded source")
+
+	return stream.SendAndClose(&consumerv1.UploadSourceResponse{
+		SourceId:  uploaded.ID,
+		Files:     uploaded.Files,
+		SizeBytes: uploaded.SizeBytes,
+	})
+}
+
+// getUploadedSource returns the uploaded source of the tenant by the given id,
+// returning nil if no id has been provided. Sources of other tenants are
+// treated the same as sources which do not exist.
+func (s Server) getUploadedSource(tenantID string, id string) (*repository.Source, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	uploaded, err := s.Repo.GetSource(id)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && uploaded.TenantID != tenantID) {
+		return nil, validation.NewError("source_id", "the source does not exist by the provided id")
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get source")
+		return nil, status.Error(codes.Internal, "failed to get source")
+	}
+
+	return &uploaded, nil
+}
@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"compile-and-run-sandbox/internal/repository"
+)
+
+// keyPrefix is prepended to every generated key to make them easily
+// identifiable, e.g. when scanning for leaked secrets.
+const keyPrefix = "cars_"
+
+type tenantKey struct{}
+
+// Tenant is the authenticated caller of the request.
+type Tenant struct {
+	ID string
+}
+
+// NewContext returns a new context carrying the tenant.
+func NewContext(ctx context.Context, tenant *Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// FromContext returns the tenant authenticated for the request, if any.
+func FromContext(ctx context.Context) (*Tenant, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(*Tenant)
+	return tenant, ok
+}
+
+// TenantID returns the id of the tenant authenticated for the request or an
+// empty string if the request is not authenticated.
+func TenantID(ctx context.Context) string {
+	if tenant, ok := FromContext(ctx); ok {
+		return tenant.ID
+	}
+
+	return ""
+}
+
+// HashKey returns the hash of the api key as stored in the repository.
+func HashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// GenerateKey returns a new random api key.
+func GenerateKey() (string, error) {
+	data := make([]byte, 32)
+
+	if _, err := rand.Read(data); err != nil {
+		return "", errors.Wrap(err, "failed to generate api key")
+	}
+
+	return keyPrefix + hex.EncodeToString(data), nil
+}
+
+type Authenticator struct {
+	repo repository.Repository
+
+	// publicMethods are the full method names which can be called without
+	// providing an api key.
+	publicMethods map[string]bool
+}
+
+func NewAuthenticator(repo repository.Repository, publicMethods ...string) *Authenticator {
+	authenticator := &Authenticator{
+		repo:          repo,
+		publicMethods: make(map[string]bool, len(publicMethods)),
+	}
+
+	for _, method := range publicMethods {
+		authenticator.publicMethods[method] = true
+	}
+
+	return authenticator
+}
+
+// UnaryServerInterceptor returns a unary interceptor which authenticates the
+// api key of the request and attaches the tenant to the context.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if a.publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		tenant, err := a.authenticate(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(NewContext(ctx, tenant), req)
+	}
+}
+
+// StreamServerInterceptor returns a stream interceptor which authenticates
+// the api key of the stream and attaches the tenant to the stream context.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if a.publicMethods[info.FullMethod] {
+			return handler(srv, stream)
+		}
+
+		tenant, err := a.authenticate(stream.Context())
+
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = NewContext(stream.Context(), tenant)
+
+		return handler(srv, wrapped)
+	}
+}
+
+func (a *Authenticator) authenticate(ctx context.Context) (*Tenant, error) {
+	key, err := keyFromMetadata(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := a.repo.GetAPIKey(HashKey(key))
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get api key")
+		return nil, status.Error(codes.Unavailable, "failed to authenticate api key")
+	}
+
+	return &Tenant{ID: apiKey.TenantID}, nil
+}
+
+// keyFromMetadata returns the api key from the authorization metadata of the
+// request, the key is expected in the form "Bearer <key>".
+func keyFromMetadata(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing api key")
+	}
+
+	scheme, key, found := strings.Cut(values[0], " ")
+
+	if !found || !strings.EqualFold(scheme, "bearer") || strings.TrimSpace(key) == "" {
+		return "", status.Error(codes.Unauthenticated, "malformed authorization, expected bearer api key")
+	}
+
+	return strings.TrimSpace(key), nil
+}
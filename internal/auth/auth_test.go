@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"compile-and-run-sandbox/internal/repository"
+)
+
+type keyRepository struct {
+	repository.Repository
+	keys map[string]repository.APIKey
+}
+
+func (k keyRepository) GetAPIKey(hash string) (repository.APIKey, error) {
+	if key, ok := k.keys[hash]; ok {
+		return key, nil
+	}
+
+	return repository.APIKey{}, gorm.ErrRecordNotFound
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	repo := keyRepository{keys: map[string]repository.APIKey{
+		HashKey("valid"): {TenantID: "tenant"},
+	}}
+
+	interceptor := NewAuthenticator(repo, "/public").UnaryServerInterceptor()
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization []string
+		code          codes.Code
+		tenantID      string
+	}{
+		{name: "valid key", method: "/private", authorization: []string{"Bearer valid"}, tenantID: "tenant"},
+		{name: "case insensitive scheme", method: "/private", authorization: []string{"bearer valid"}, tenantID: "tenant"},
+		{name: "unknown key", method: "/private", authorization: []string{"Bearer invalid"}, code: codes.Unauthenticated},
+		{name: "malformed authorization", method: "/private", authorization: []string{"valid"}, code: codes.Unauthenticated},
+		{name: "missing authorization", method: "/private", code: codes.Unauthenticated},
+		{name: "public method", method: "/public"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+
+			if tt.authorization != nil {
+				md.Set("authorization", tt.authorization...)
+			}
+
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			var tenantID string
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					tenantID = TenantID(ctx)
+					return nil, nil
+				})
+
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Equal(t, tt.tenantID, tenantID)
+		})
+	}
+}
+
+func TestGenerateKey(t *testing.T) {
+	first, err := GenerateKey()
+	assert.NoError(t, err)
+
+	second, err := GenerateKey()
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+	assert.NotEqual(t, HashKey(first), HashKey(second))
+	assert.Equal(t, HashKey(first), HashKey(first))
+}
+
+func TestClientIdentityFromContext(t *testing.T) {
+	certificate := &x509.Certificate{
+		Subject:      pkix.Name{CommonName: "client"},
+		Issuer:       pkix.Name{CommonName: "ca"},
+		DNSNames:     []string{"client.local"},
+		SerialNumber: big.NewInt(42),
+	}
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{certificate}},
+		}},
+	})
+
+	identity, ok := ClientIdentityFromContext(ctx)
+
+	assert.True(t, ok)
+	assert.Equal(t, &ClientIdentity{
+		CommonName:   "client",
+		DNSNames:     []string{"client.local"},
+		SerialNumber: "42",
+		Issuer:       "CN=ca",
+	}, identity)
+
+	_, ok = ClientIdentityFromContext(peer.NewContext(context.Background(), &peer.Peer{}))
+	assert.False(t, ok)
+}
+
+func TestIdentityForwarder(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{Subject: pkix.Name{CommonName: "forwarded"}, SerialNumber: big.NewInt(7)}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	gateway := &x509.Certificate{Subject: pkix.Name{CommonName: "gateway"}, SerialNumber: big.NewInt(1)}
+	client := &x509.Certificate{Subject: pkix.Name{CommonName: "client"}, SerialNumber: big.NewInt(2)}
+
+	forwarder := NewIdentityForwarder(func(certificate *x509.Certificate) bool {
+		return certificate == gateway
+	})
+
+	tests := []struct {
+		name       string
+		peer       *x509.Certificate
+		forwarded  []string
+		commonName string
+	}{
+		{name: "trusted gateway", peer: gateway, forwarded: []string{base64.StdEncoding.EncodeToString(der)}, commonName: "forwarded"},
+		{name: "trusted gateway without certificate", peer: gateway},
+		{name: "trusted gateway with invalid certificate", peer: gateway, forwarded: []string{"invalid"}},
+		{name: "untrusted client", peer: client, forwarded: []string{base64.StdEncoding.EncodeToString(der)}, commonName: "client"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+
+			if tt.forwarded != nil {
+				md.Set(ForwardedClientCertKey, tt.forwarded...)
+			}
+
+			ctx := peer.NewContext(metadata.NewIncomingContext(context.Background(), md), &peer.Peer{
+				AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+					VerifiedChains: [][]*x509.Certificate{{tt.peer}},
+				}},
+			})
+
+			var commonName string
+
+			_, err := forwarder.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					if identity, ok := ClientIdentityFromContext(ctx); ok {
+						commonName = identity.CommonName
+					}
+
+					return nil, nil
+				})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.commonName, commonName)
+		})
+	}
+}
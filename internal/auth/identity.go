@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ForwardedClientCertKey is the metadata key the gateway forwards the verified
+// client certificate of the http request with, as base64 encoded DER.
+const ForwardedClientCertKey = "x-cars-forwarded-client-cert"
+
+type identityKey struct{}
+
+// ClientIdentity is the identity of the verified client certificate the
+// request was made with when mutual tls is enabled.
+type ClientIdentity struct {
+	CommonName   string
+	DNSNames     []string
+	SerialNumber string
+	Issuer       string
+}
+
+// ClientIdentityFromContext returns the identity of the verified client
+// certificate of the request, if the request was made with one. Requests
+// proxied by a trusted gateway return the identity forwarded by the gateway.
+func ClientIdentityFromContext(ctx context.Context) (*ClientIdentity, bool) {
+	if identity, ok := ctx.Value(identityKey{}).(*ClientIdentity); ok {
+		return identity, identity != nil
+	}
+
+	certificate, ok := peerCertificate(ctx)
+
+	if !ok {
+		return nil, false
+	}
+
+	return newClientIdentity(certificate), true
+}
+
+// peerCertificate returns the verified client certificate of the connection.
+func peerCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+
+	if !ok {
+		return nil, false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0], true
+}
+
+// IdentityForwarder replaces the client identity of requests proxied by a
+// trusted gateway with the identity of the client certificate the gateway
+// forwarded, so the original caller is audited instead of the gateway.
+type IdentityForwarder struct {
+	// trusted returns true if the client certificate of the connection is of
+	// a gateway trusted to forward client certificates.
+	trusted func(certificate *x509.Certificate) bool
+}
+
+func NewIdentityForwarder(trusted func(certificate *x509.Certificate) bool) *IdentityForwarder {
+	return &IdentityForwarder{trusted: trusted}
+}
+
+// UnaryServerInterceptor returns a unary interceptor which attaches the
+// forwarded client identity to the context.
+func (f *IdentityForwarder) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(f.forward(ctx), req)
+	}
+}
+
+// StreamServerInterceptor returns a stream interceptor which attaches the
+// forwarded client identity to the stream context.
+func (f *IdentityForwarder) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = f.forward(stream.Context())
+
+		return handler(srv, wrapped)
+	}
+}
+
+// forward returns the context with the forwarded client identity, the
+// forwarded certificate is ignored unless the connection is from a trusted
+// gateway.
+func (f *IdentityForwarder) forward(ctx context.Context) context.Context {
+	certificate, ok := peerCertificate(ctx)
+
+	if !ok || !f.trusted(certificate) {
+		return ctx
+	}
+
+	// without a forwarded certificate the request has no client identity,
+	// rather than being attributed to the gateway.
+	return context.WithValue(ctx, identityKey{}, forwardedIdentity(ctx))
+}
+
+// forwardedIdentity returns the identity of the forwarded client certificate
+// or nil if no valid certificate was forwarded.
+func forwardedIdentity(ctx context.Context) *ClientIdentity {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(ForwardedClientCertKey)
+
+	if len(values) != 1 {
+		return nil
+	}
+
+	der, err := base64.StdEncoding.DecodeString(values[0])
+
+	if err != nil {
+		return nil
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		return nil
+	}
+
+	return newClientIdentity(certificate)
+}
+
+func newClientIdentity(certificate *x509.Certificate) *ClientIdentity {
+	return &ClientIdentity{
+		CommonName:   certificate.Subject.CommonName,
+		DNSNames:     certificate.DNSNames,
+		SerialNumber: certificate.SerialNumber.String(),
+		Issuer:       certificate.Issuer.String(),
+	}
+}
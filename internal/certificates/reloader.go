@@ -0,0 +1,263 @@
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+type Config struct {
+	// CertFile and KeyFile are the paths to the PEM encoded certificate and
+	// private key presented by the server.
+	CertFile string
+	KeyFile  string
+
+	// ClientCAFile is the optional path to the PEM encoded certificate
+	// authorities used to verify client certificates. If set every client is
+	// required to present a certificate signed by one of the authorities.
+	ClientCAFile string
+
+	// GatewayCertFile and GatewayKeyFile are the optional paths to the PEM
+	// encoded client certificate and private key presented by the http
+	// gateway when connecting to the server. Required when client
+	// certificates are required, the certificate must be valid for client
+	// authentication and signed by one of the client certificate authorities.
+	GatewayCertFile string
+	GatewayKeyFile  string
+}
+
+// Enabled returns true if a certificate has been configured.
+func (c *Config) Enabled() bool {
+	return c.CertFile != "" && c.KeyFile != ""
+}
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// Reloader holds the currently loaded certificate and client certificate
+// authorities, reloading them when the files change without requiring the
+// server to be restarted.
+type Reloader struct {
+	config *Config
+
+	mu                 sync.RWMutex
+	certificate        *tls.Certificate
+	gatewayCertificate *tls.Certificate
+	clientCAs          *x509.CertPool
+	states             map[string]fileState
+}
+
+func NewReloader(config *Config) (*Reloader, error) {
+	if config.ClientCAFile != "" && (config.GatewayCertFile == "" || config.GatewayKeyFile == "") {
+		return nil, fmt.Errorf("a gateway client certificate is required when client certificates are required")
+	}
+
+	reloader := &Reloader{config: config}
+
+	if err := reloader.reload(); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+// Watch checks the files for changes every interval until the context is
+// done, reloading the certificates if any of the files changed. Failing to
+// reload keeps the previously loaded certificates.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !r.changed() {
+			continue
+		}
+
+		if err := r.reload(); err != nil {
+			log.Error().Err(err).Msg("failed to reload certificates, keeping previous certificates")
+			continue
+		}
+
+		log.Info().Str("cert", r.config.CertFile).Msg("reloaded certificates")
+	}
+}
+
+// ServerTLSConfig returns the server tls configuration, every new connection
+// uses the latest loaded certificates.
+func (r *Reloader) ServerTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.certificate},
+			}
+
+			if r.clientCAs != nil {
+				config.ClientCAs = r.clientCAs
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+
+			return config, nil
+		},
+	}
+}
+
+// LoopbackTLSConfig returns the client tls configuration used by the gateway
+// to connect to the server. The server certificate is pinned instead of
+// verified against a certificate authority and the gateway certificate is
+// presented as the client certificate when client certificates are required.
+func (r *Reloader) LoopbackTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// the chain is not verified since the exact certificate is pinned
+		// within VerifyConnection instead.
+		InsecureSkipVerify: true, // nolint:gosec // certificate is pinned
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			// an empty certificate sends no client certificate.
+			if r.gatewayCertificate == nil {
+				return &tls.Certificate{}, nil
+			}
+
+			return r.gatewayCertificate, nil
+		},
+		VerifyConnection: func(state tls.ConnectionState) error {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			if len(state.PeerCertificates) == 0 || !bytes.Equal(state.PeerCertificates[0].Raw, r.certificate.Certificate[0]) {
+				return fmt.Errorf("server certificate does not match the loaded certificate")
+			}
+
+			return nil
+		},
+	}
+}
+
+// IsGatewayCertificate returns true if the certificate is the loaded gateway
+// certificate, used to trust the client identity forwarded by the gateway.
+func (r *Reloader) IsGatewayCertificate(certificate *x509.Certificate) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.gatewayCertificate != nil && bytes.Equal(certificate.Raw, r.gatewayCertificate.Certificate[0])
+}
+
+func (r *Reloader) reload() error {
+	states, err := r.fileStates()
+
+	if err != nil {
+		return err
+	}
+
+	certificate, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to load certificate")
+	}
+
+	var gatewayCertificate *tls.Certificate
+
+	if r.config.GatewayCertFile != "" {
+		pair, pairErr := tls.LoadX509KeyPair(r.config.GatewayCertFile, r.config.GatewayKeyFile)
+
+		if pairErr != nil {
+			return errors.Wrap(pairErr, "failed to load gateway certificate")
+		}
+
+		gatewayCertificate = &pair
+	}
+
+	var clientCAs *x509.CertPool
+
+	if r.config.ClientCAFile != "" {
+		data, readErr := os.ReadFile(r.config.ClientCAFile)
+
+		if readErr != nil {
+			return errors.Wrap(readErr, "failed to read client certificate authorities")
+		}
+
+		clientCAs = x509.NewCertPool()
+
+		if !clientCAs.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no client certificate authorities found in %s", r.config.ClientCAFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.certificate = &certificate
+	r.gatewayCertificate = gatewayCertificate
+	r.clientCAs = clientCAs
+	r.states = states
+
+	return nil
+}
+
+// changed returns true if any of the files have been modified since they
+// were last loaded.
+func (r *Reloader) changed() bool {
+	states, err := r.fileStates()
+
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to check certificate files")
+		return false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for path, state := range states {
+		if r.states[path] != state {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Reloader) fileStates() (map[string]fileState, error) {
+	states := map[string]fileState{}
+
+	for _, path := range []string{
+		r.config.CertFile, r.config.KeyFile, r.config.ClientCAFile,
+		r.config.GatewayCertFile, r.config.GatewayKeyFile,
+	} {
+		if path == "" {
+			continue
+		}
+
+		info, err := os.Stat(path)
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to stat %s", path)
+		}
+
+		states[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+	}
+
+	return states, nil
+}
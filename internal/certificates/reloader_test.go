@@ -0,0 +1,247 @@
+package certificates
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certPEM     []byte
+	keyPEM      []byte
+}
+
+// newTestCertificate creates a certificate valid for the given usages signed
+// by the parent, or a self signed certificate authority if no parent is given.
+func newTestCertificate(t *testing.T, commonName string, parent *testCertificate, usages ...x509.ExtKeyUsage) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  usages,
+
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+	}
+
+	signer, signerKey := template, key
+
+	if parent != nil {
+		signer, signerKey = parent.certificate, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return &testCertificate{
+		certificate: certificate,
+		key:         key,
+		certPEM:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:      pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+	}
+}
+
+func writeCertificate(t *testing.T, config *Config, certificate *testCertificate) {
+	require.NoError(t, os.WriteFile(config.CertFile, certificate.certPEM, 0o600))
+	require.NoError(t, os.WriteFile(config.KeyFile, certificate.keyPEM, 0o600))
+}
+
+// serve accepts tls connections on a random port, completing the handshake
+// of every connection.
+func serve(t *testing.T, config *tls.Config) string {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, acceptErr := listener.Accept()
+
+			if acceptErr != nil {
+				return
+			}
+
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+// dial returns the certificate presented by the server.
+func dial(address string, config *tls.Config) (*x509.Certificate, error) {
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", address, config)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	// the server only verifies the client certificate once the client has
+	// attempted to read, surfacing any rejection of the client certificate.
+	_ = conn.SetReadDeadline(time.Now().Add(time.Millisecond * 100))
+	_, readErr := conn.Read(make([]byte, 1))
+
+	var netErr net.Error
+
+	if readErr != nil && !errors.Is(readErr, io.EOF) && !(errors.As(readErr, &netErr) && netErr.Timeout()) {
+		return nil, readErr
+	}
+
+	return conn.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestReloaderReloadsChangedCertificate(t *testing.T) {
+	dir := t.TempDir()
+	config := &Config{CertFile: filepath.Join(dir, "tls.crt"), KeyFile: filepath.Join(dir, "tls.key")}
+
+	first := newTestCertificate(t, "first", nil)
+	writeCertificate(t, config, first)
+
+	reloader, err := NewReloader(config)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go reloader.Watch(ctx, time.Millisecond*10)
+
+	address := serve(t, reloader.ServerTLSConfig())
+	clientConfig := &tls.Config{InsecureSkipVerify: true} // nolint:gosec // test only checks the presented certificate
+
+	presented, err := dial(address, clientConfig)
+	require.NoError(t, err)
+	assert.Equal(t, "first", presented.Subject.CommonName)
+
+	// ensure the modification time changes on file systems with a coarse
+	// time resolution.
+	time.Sleep(time.Millisecond * 20)
+
+	second := newTestCertificate(t, "second", nil)
+	writeCertificate(t, config, second)
+
+	assert.Eventually(t, func() bool {
+		presented, err = dial(address, clientConfig)
+		return err == nil && presented.Subject.CommonName == "second"
+	}, time.Second*2, time.Millisecond*20)
+}
+
+func TestReloaderKeepsCertificateOnInvalidReload(t *testing.T) {
+	dir := t.TempDir()
+	config := &Config{CertFile: filepath.Join(dir, "tls.crt"), KeyFile: filepath.Join(dir, "tls.key")}
+
+	writeCertificate(t, config, newTestCertificate(t, "first", nil))
+
+	reloader, err := NewReloader(config)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(config.CertFile, []byte("invalid"), 0o600))
+	assert.Error(t, reloader.reload())
+
+	leaf, err := x509.ParseCertificate(reloader.certificate.Certificate[0])
+	require.NoError(t, err)
+	assert.Equal(t, "first", leaf.Subject.CommonName)
+}
+
+func TestReloaderMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+
+	ca := newTestCertificate(t, "ca", nil)
+	server := newTestCertificate(t, "server", ca, x509.ExtKeyUsageServerAuth)
+	gateway := newTestCertificate(t, "gateway", ca, x509.ExtKeyUsageClientAuth)
+	client := newTestCertificate(t, "client", ca, x509.ExtKeyUsageClientAuth)
+	untrusted := newTestCertificate(t, "untrusted", nil)
+
+	config := &Config{
+		CertFile:        filepath.Join(dir, "tls.crt"),
+		KeyFile:         filepath.Join(dir, "tls.key"),
+		ClientCAFile:    filepath.Join(dir, "ca.crt"),
+		GatewayCertFile: filepath.Join(dir, "gateway.crt"),
+		GatewayKeyFile:  filepath.Join(dir, "gateway.key"),
+	}
+
+	writeCertificate(t, config, server)
+	require.NoError(t, os.WriteFile(config.ClientCAFile, ca.certPEM, 0o600))
+	require.NoError(t, os.WriteFile(config.GatewayCertFile, gateway.certPEM, 0o600))
+	require.NoError(t, os.WriteFile(config.GatewayKeyFile, gateway.keyPEM, 0o600))
+
+	reloader, err := NewReloader(config)
+	require.NoError(t, err)
+
+	address := serve(t, reloader.ServerTLSConfig())
+
+	clientConfig := func(certificate *testCertificate) *tls.Config {
+		pair, pairErr := tls.X509KeyPair(certificate.certPEM, certificate.keyPEM)
+		require.NoError(t, pairErr)
+
+		return &tls.Config{
+			InsecureSkipVerify: true, // nolint:gosec // test only checks the client certificate
+			Certificates:       []tls.Certificate{pair},
+		}
+	}
+
+	_, err = dial(address, clientConfig(client))
+	assert.NoError(t, err, "client signed by the ca should be accepted")
+
+	_, err = dial(address, clientConfig(untrusted))
+	assert.Error(t, err, "client not signed by the ca should be rejected")
+
+	_, err = dial(address, &tls.Config{InsecureSkipVerify: true}) // nolint:gosec // test
+	assert.Error(t, err, "client without a certificate should be rejected")
+
+	_, err = dial(address, clientConfig(server))
+	assert.Error(t, err, "certificate without client authentication should be rejected")
+
+	// the loopback configuration presents the gateway certificate and pins
+	// the server certificate.
+	_, err = dial(address, reloader.LoopbackTLSConfig())
+	assert.NoError(t, err)
+
+	assert.True(t, reloader.IsGatewayCertificate(gateway.certificate))
+	assert.False(t, reloader.IsGatewayCertificate(client.certificate))
+}
+
+func TestReloaderRequiresGatewayCertificate(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := NewReloader(&Config{
+		CertFile:     filepath.Join(dir, "tls.crt"),
+		KeyFile:      filepath.Join(dir, "tls.key"),
+		ClientCAFile: filepath.Join(dir, "ca.crt"),
+	})
+
+	assert.EqualError(t, err, "a gateway client certificate is required when client certificates are required")
+}
@@ -31,6 +31,9 @@ type Files interface {
 	WriteFile(file *File) error
 
 	GetFile(id string, name string) ([]byte, error)
+
+	// Ping ensures the files can be written to the backend.
+	Ping() error
 }
 
 func NewFilesHandler(config *Config) (Files, error) {
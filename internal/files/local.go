@@ -36,7 +36,9 @@ func (l LocalFiles) WriteFile(file *File) error {
 	folderDirectory := filepath.Join(l.config.LocalRootPath, file.ID)
 	filePath := filepath.Join(folderDirectory, file.Name)
 
-	if err := os.MkdirAll(folderDirectory, 0o750); err != nil {
+	// the name can contain directories, such as the files of a multi-file
+	// submission, these are created alongside the folder of the id.
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
 		return errors.Wrap(err, "failed to make required directories")
 	}
 
@@ -99,3 +101,18 @@ func (l LocalFiles) GetFile(id string, name string) ([]byte, error) {
 
 	return data, nil
 }
+
+func (l LocalFiles) Ping() error {
+	if err := os.MkdirAll(l.config.LocalRootPath, 0o750); err != nil {
+		return errors.Wrap(err, "failed to make root directory")
+	}
+
+	file, err := os.CreateTemp(l.config.LocalRootPath, ".ping-*")
+
+	if err != nil {
+		return errors.Wrap(err, "root directory is not writable")
+	}
+
+	_ = file.Close()
+	return os.Remove(file.Name())
+}
@@ -94,3 +94,11 @@ func (s S3Files) GetFile(id string, name string) ([]byte, error) {
 
 	return buffer.Bytes(), err
 }
+
+func (s S3Files) Ping() error {
+	_, err := s.s3.HeadBucket(&s3.HeadBucketInput{
+		Bucket: aws.String(s.config.BucketName),
+	})
+
+	return errors.Wrapf(err, "failed to access bucket %s", s.config.BucketName)
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1-devel
+// 	protoc-gen-go v1.31.0-devel
 // 	protoc        (unknown)
 // source: content/consumer/v1/consumer.proto
 
@@ -14,6 +14,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -23,6 +24,195 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The policies of scoring a group of test cases.
+type ScoringPolicy int32
+
+const (
+	// Scores the group all or nothing, the same as SCORING_POLICY_ALL_OR_NOTHING.
+	ScoringPolicy_SCORING_POLICY_UNSPECIFIED ScoringPolicy = 0
+	// The group is given its full weight only if every test case passed,
+	// otherwise nothing.
+	ScoringPolicy_SCORING_POLICY_ALL_OR_NOTHING ScoringPolicy = 1
+	// The group is given its weight in proportion to the average score of its
+	// test cases.
+	ScoringPolicy_SCORING_POLICY_PROPORTIONAL ScoringPolicy = 2
+	// The group is given its weight in proportion to the lowest score of its
+	// test cases.
+	ScoringPolicy_SCORING_POLICY_MIN ScoringPolicy = 3
+)
+
+// Enum value maps for ScoringPolicy.
+var (
+	ScoringPolicy_name = map[int32]string{
+		0: "SCORING_POLICY_UNSPECIFIED",
+		1: "SCORING_POLICY_ALL_OR_NOTHING",
+		2: "SCORING_POLICY_PROPORTIONAL",
+		3: "SCORING_POLICY_MIN",
+	}
+	ScoringPolicy_value = map[string]int32{
+		"SCORING_POLICY_UNSPECIFIED":    0,
+		"SCORING_POLICY_ALL_OR_NOTHING": 1,
+		"SCORING_POLICY_PROPORTIONAL":   2,
+		"SCORING_POLICY_MIN":            3,
+	}
+)
+
+func (x ScoringPolicy) Enum() *ScoringPolicy {
+	p := new(ScoringPolicy)
+	*p = x
+	return p
+}
+
+func (x ScoringPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScoringPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_consumer_v1_consumer_proto_enumTypes[0].Descriptor()
+}
+
+func (ScoringPolicy) Type() protoreflect.EnumType {
+	return &file_content_consumer_v1_consumer_proto_enumTypes[0]
+}
+
+func (x ScoringPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScoringPolicy.Descriptor instead.
+func (ScoringPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{0}
+}
+
+// The modes of comparing the standard output with the expected output.
+type ComparisonMode int32
+
+const (
+	// Compares the output exactly, the same as COMPARISON_MODE_EXACT.
+	ComparisonMode_COMPARISON_MODE_UNSPECIFIED ComparisonMode = 0
+	// Every line must be exactly the same.
+	ComparisonMode_COMPARISON_MODE_EXACT ComparisonMode = 1
+	// Ignores the whitespace at the end of each line, including carriage
+	// returns, and any blank lines at the end of the output.
+	ComparisonMode_COMPARISON_MODE_IGNORE_TRAILING_WHITESPACE ComparisonMode = 2
+	// Compares the whitespace separated tokens, ignoring how the tokens are
+	// split across lines.
+	ComparisonMode_COMPARISON_MODE_TOKENS ComparisonMode = 3
+	// Every line must be the same ignoring case.
+	ComparisonMode_COMPARISON_MODE_CASE_INSENSITIVE ComparisonMode = 4
+	// Compares the whitespace separated tokens, numeric tokens are equal if
+	// within the absolute or the relative epsilon of each other.
+	ComparisonMode_COMPARISON_MODE_FLOAT ComparisonMode = 5
+	// The output must contain the same lines in any order, ignoring the
+	// trailing whitespace of each line and any blank lines at the end.
+	ComparisonMode_COMPARISON_MODE_UNORDERED_LINES ComparisonMode = 6
+)
+
+// Enum value maps for ComparisonMode.
+var (
+	ComparisonMode_name = map[int32]string{
+		0: "COMPARISON_MODE_UNSPECIFIED",
+		1: "COMPARISON_MODE_EXACT",
+		2: "COMPARISON_MODE_IGNORE_TRAILING_WHITESPACE",
+		3: "COMPARISON_MODE_TOKENS",
+		4: "COMPARISON_MODE_CASE_INSENSITIVE",
+		5: "COMPARISON_MODE_FLOAT",
+		6: "COMPARISON_MODE_UNORDERED_LINES",
+	}
+	ComparisonMode_value = map[string]int32{
+		"COMPARISON_MODE_UNSPECIFIED":                0,
+		"COMPARISON_MODE_EXACT":                      1,
+		"COMPARISON_MODE_IGNORE_TRAILING_WHITESPACE": 2,
+		"COMPARISON_MODE_TOKENS":                     3,
+		"COMPARISON_MODE_CASE_INSENSITIVE":           4,
+		"COMPARISON_MODE_FLOAT":                      5,
+		"COMPARISON_MODE_UNORDERED_LINES":            6,
+	}
+)
+
+func (x ComparisonMode) Enum() *ComparisonMode {
+	p := new(ComparisonMode)
+	*p = x
+	return p
+}
+
+func (x ComparisonMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ComparisonMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_consumer_v1_consumer_proto_enumTypes[1].Descriptor()
+}
+
+func (ComparisonMode) Type() protoreflect.EnumType {
+	return &file_content_consumer_v1_consumer_proto_enumTypes[1]
+}
+
+func (x ComparisonMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ComparisonMode.Descriptor instead.
+func (ComparisonMode) EnumDescriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{1}
+}
+
+// The format of an uploaded source.
+type SourceFormat int32
+
+const (
+	SourceFormat_SOURCE_FORMAT_UNSPECIFIED SourceFormat = 0
+	// A single file uploaded as is.
+	SourceFormat_SOURCE_FORMAT_PLAIN SourceFormat = 1
+	// A zip archive of many files.
+	SourceFormat_SOURCE_FORMAT_ZIP SourceFormat = 2
+	// A gzip compressed tar archive of many files.
+	SourceFormat_SOURCE_FORMAT_TAR_GZ SourceFormat = 3
+)
+
+// Enum value maps for SourceFormat.
+var (
+	SourceFormat_name = map[int32]string{
+		0: "SOURCE_FORMAT_UNSPECIFIED",
+		1: "SOURCE_FORMAT_PLAIN",
+		2: "SOURCE_FORMAT_ZIP",
+		3: "SOURCE_FORMAT_TAR_GZ",
+	}
+	SourceFormat_value = map[string]int32{
+		"SOURCE_FORMAT_UNSPECIFIED": 0,
+		"SOURCE_FORMAT_PLAIN":       1,
+		"SOURCE_FORMAT_ZIP":         2,
+		"SOURCE_FORMAT_TAR_GZ":      3,
+	}
+)
+
+func (x SourceFormat) Enum() *SourceFormat {
+	p := new(SourceFormat)
+	*p = x
+	return p
+}
+
+func (x SourceFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SourceFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_consumer_v1_consumer_proto_enumTypes[2].Descriptor()
+}
+
+func (SourceFormat) Type() protoreflect.EnumType {
+	return &file_content_consumer_v1_consumer_proto_enumTypes[2]
+}
+
+func (x SourceFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SourceFormat.Descriptor instead.
+func (SourceFormat) EnumDescriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{2}
+}
+
 // The response from the ping.
 type PingResponse struct {
 	state         protoimpl.MessageState
@@ -80,6 +270,10 @@ type GetTemplateRequest struct {
 
 	// The language which template should be returned.
 	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	// The optional variant of the template, e.g. "fast-io" or "stdin-echo". If
+	// not set the default template of the language is returned. The variants
+	// of every language are listed by ListTemplates.
+	Variant string `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
 }
 
 func (x *GetTemplateRequest) Reset() {
@@ -121,6 +315,13 @@ func (x *GetTemplateRequest) GetLanguage() string {
 	return ""
 }
 
+func (x *GetTemplateRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
 // Returns the template code for a given language. This template can compile
 // and run safely out of the box.
 type GetTemplateResponse struct {
@@ -171,22 +372,18 @@ func (x *GetTemplateResponse) GetTemplate() string {
 	return ""
 }
 
-// A possible supported language information.
-type SupportedLanguage struct {
+// Used to list the template variants, optionally of a single language.
+type ListTemplatesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The language code send during the compile request, this is not the same as
-	// the display name. This is also the code used to get the template.
-	LanguageCode string `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
-	// The display name the user can be shown and will understand for example
-	// the display name could be C# and the code would be csharp.
-	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The optional language the variants should be listed for.
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
 }
 
-func (x *SupportedLanguage) Reset() {
-	*x = SupportedLanguage{}
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_content_consumer_v1_consumer_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -194,13 +391,13 @@ func (x *SupportedLanguage) Reset() {
 	}
 }
 
-func (x *SupportedLanguage) String() string {
+func (x *ListTemplatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SupportedLanguage) ProtoMessage() {}
+func (*ListTemplatesRequest) ProtoMessage() {}
 
-func (x *SupportedLanguage) ProtoReflect() protoreflect.Message {
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_content_consumer_v1_consumer_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -212,37 +409,30 @@ func (x *SupportedLanguage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SupportedLanguage.ProtoReflect.Descriptor instead.
-func (*SupportedLanguage) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
 	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SupportedLanguage) GetLanguageCode() string {
-	if x != nil {
-		return x.LanguageCode
-	}
-	return ""
-}
-
-func (x *SupportedLanguage) GetDisplayName() string {
+func (x *ListTemplatesRequest) GetLanguage() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Language
 	}
 	return ""
 }
 
-// Contains the list of supported languages currently.
-type GetSupportedLanguagesResponse struct {
+// Contains the template variants ordered by the language and the variant.
+type ListTemplatesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The list of supported languages within the system.
-	Languages []*SupportedLanguage `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"`
+	// The list of template variants.
+	Templates []*TemplateVariant `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
 }
 
-func (x *GetSupportedLanguagesResponse) Reset() {
-	*x = GetSupportedLanguagesResponse{}
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_content_consumer_v1_consumer_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -250,13 +440,13 @@ func (x *GetSupportedLanguagesResponse) Reset() {
 	}
 }
 
-func (x *GetSupportedLanguagesResponse) String() string {
+func (x *ListTemplatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSupportedLanguagesResponse) ProtoMessage() {}
+func (*ListTemplatesResponse) ProtoMessage() {}
 
-func (x *GetSupportedLanguagesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_content_consumer_v1_consumer_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -268,44 +458,32 @@ func (x *GetSupportedLanguagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSupportedLanguagesResponse.ProtoReflect.Descriptor instead.
-func (*GetSupportedLanguagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
 	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetSupportedLanguagesResponse) GetLanguages() []*SupportedLanguage {
+func (x *ListTemplatesResponse) GetTemplates() []*TemplateVariant {
 	if x != nil {
-		return x.Languages
+		return x.Templates
 	}
 	return nil
 }
 
-// The request to compile and run code.
-type CreateCompileRequest struct {
+// A single variant of the template of a language.
+type TemplateVariant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The target language that is being sent. Incorrectly setting this will
-	// result in a faulted request.
+	// The language code of the template.
 	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
-	// The source code that will be executed, this should be well formatted as
-	// if it was ready to be compiled. Misconfigured ro formatted code will be
-	// rejected by the runtime or compiler.
-	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	// This array of strings will be written to the standard input of the code
-	// when executing. Each array item is a line which will be written one after
-	// another.
-	StandardInData []string `protobuf:"bytes,3,rep,name=standard_in_data,json=standardInData,proto3" json:"standard_in_data,omitempty"`
-	// This is an array of expected output data, including data here that will
-	// result in a validation check on completion. If no items are added to the
-	// array then the status endpoint will return NoTest for the test status.
-	// Otherwise, a value related to the test result.
-	ExpectedStandardOutData []string `protobuf:"bytes,4,rep,name=expected_standard_out_data,json=expectedStandardOutData,proto3" json:"expected_standard_out_data,omitempty"`
+	// The name of the variant, used within GetTemplate.
+	Variant string `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
 }
 
-func (x *CreateCompileRequest) Reset() {
-	*x = CreateCompileRequest{}
+func (x *TemplateVariant) Reset() {
+	*x = TemplateVariant{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_content_consumer_v1_consumer_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -313,13 +491,13 @@ func (x *CreateCompileRequest) Reset() {
 	}
 }
 
-func (x *CreateCompileRequest) String() string {
+func (x *TemplateVariant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCompileRequest) ProtoMessage() {}
+func (*TemplateVariant) ProtoMessage() {}
 
-func (x *CreateCompileRequest) ProtoReflect() protoreflect.Message {
+func (x *TemplateVariant) ProtoReflect() protoreflect.Message {
 	mi := &file_content_consumer_v1_consumer_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -331,52 +509,63 @@ func (x *CreateCompileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCompileRequest.ProtoReflect.Descriptor instead.
-func (*CreateCompileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TemplateVariant.ProtoReflect.Descriptor instead.
+func (*TemplateVariant) Descriptor() ([]byte, []int) {
 	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateCompileRequest) GetLanguage() string {
+func (x *TemplateVariant) GetLanguage() string {
 	if x != nil {
 		return x.Language
 	}
 	return ""
 }
 
-func (x *CreateCompileRequest) GetSource() string {
+func (x *TemplateVariant) GetVariant() string {
 	if x != nil {
-		return x.Source
+		return x.Variant
 	}
 	return ""
 }
 
-func (x *CreateCompileRequest) GetStandardInData() []string {
-	if x != nil {
-		return x.StandardInData
-	}
-	return nil
-}
-
-func (x *CreateCompileRequest) GetExpectedStandardOutData() []string {
-	if x != nil {
-		return x.ExpectedStandardOutData
-	}
-	return nil
-}
-
-// The response when requesting a compiled request via the queue.
-type CreateCompileResponse struct {
+// A possible supported language information.
+type SupportedLanguage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The reference ID of the compile request. Use later to retrieve updated
-	// information regarding the state of the execution.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The language code send during the compile request, this is not the same as
+	// the display name. This is also the code used to get the template.
+	LanguageCode string `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	// The display name the user can be shown and will understand for example
+	// the display name could be C# and the code would be csharp.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The version of the toolchain the code runs on, e.g. "rustc 1.74.0",
+	// probed from the language image when the loader starts. Empty until the
+	// version has been probed.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// The commands used to compile the code, empty for interpreted languages.
+	// Commands can contain placeholders such as {{.ID}}.
+	CompileCommands []string `protobuf:"bytes,4,rep,name=compile_commands,json=compileCommands,proto3" json:"compile_commands,omitempty"`
+	// The command used to run the code.
+	RunCommand string `protobuf:"bytes,5,opt,name=run_command,json=runCommand,proto3" json:"run_command,omitempty"`
+	// The name of the file the source is written to.
+	SourceFile string `protobuf:"bytes,6,opt,name=source_file,json=sourceFile,proto3" json:"source_file,omitempty"`
+	// If the language is interpreted instead of compiled.
+	Interpreted bool `protobuf:"varint,7,opt,name=interpreted,proto3" json:"interpreted,omitempty"`
+	// The default and maximum limits of the language.
+	Limits *LanguageLimits `protobuf:"bytes,8,opt,name=limits,proto3" json:"limits,omitempty"`
+	// If a template is available through GetTemplate.
+	TemplateAvailable bool `protobuf:"varint,9,opt,name=template_available,json=templateAvailable,proto3" json:"template_available,omitempty"`
+	// If the language image was available when last checked by the loaders.
+	// Languages not checked recently are reported as unavailable.
+	Available bool `protobuf:"varint,10,opt,name=available,proto3" json:"available,omitempty"`
+	// The last time the availability of the language was checked.
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
 }
 
-func (x *CreateCompileResponse) Reset() {
-	*x = CreateCompileResponse{}
+func (x *SupportedLanguage) Reset() {
+	*x = SupportedLanguage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_content_consumer_v1_consumer_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -384,13 +573,13 @@ func (x *CreateCompileResponse) Reset() {
 	}
 }
 
-func (x *CreateCompileResponse) String() string {
+func (x *SupportedLanguage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCompileResponse) ProtoMessage() {}
+func (*SupportedLanguage) ProtoMessage() {}
 
-func (x *CreateCompileResponse) ProtoReflect() protoreflect.Message {
+func (x *SupportedLanguage) ProtoReflect() protoreflect.Message {
 	mi := &file_content_consumer_v1_consumer_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -402,32 +591,105 @@ func (x *CreateCompileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCompileResponse.ProtoReflect.Descriptor instead.
-func (*CreateCompileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SupportedLanguage.ProtoReflect.Descriptor instead.
+func (*SupportedLanguage) Descriptor() ([]byte, []int) {
 	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateCompileResponse) GetId() string {
+func (x *SupportedLanguage) GetLanguageCode() string {
 	if x != nil {
-		return x.Id
+		return x.LanguageCode
 	}
 	return ""
 }
 
-// Compile result request can be used to request updated information about the
-// state or result of the compile request.
-type GetCompileResultRequest struct {
+func (x *SupportedLanguage) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *SupportedLanguage) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SupportedLanguage) GetCompileCommands() []string {
+	if x != nil {
+		return x.CompileCommands
+	}
+	return nil
+}
+
+func (x *SupportedLanguage) GetRunCommand() string {
+	if x != nil {
+		return x.RunCommand
+	}
+	return ""
+}
+
+func (x *SupportedLanguage) GetSourceFile() string {
+	if x != nil {
+		return x.SourceFile
+	}
+	return ""
+}
+
+func (x *SupportedLanguage) GetInterpreted() bool {
+	if x != nil {
+		return x.Interpreted
+	}
+	return false
+}
+
+func (x *SupportedLanguage) GetLimits() *LanguageLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *SupportedLanguage) GetTemplateAvailable() bool {
+	if x != nil {
+		return x.TemplateAvailable
+	}
+	return false
+}
+
+func (x *SupportedLanguage) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *SupportedLanguage) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// The default limits applied to a compile request and the maximum limits a
+// compile request can request.
+type LanguageLimits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The id of the request, this value would have been returned by the compile
-	// execution request.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TimeLimitMs           uint32 `protobuf:"varint,1,opt,name=time_limit_ms,json=timeLimitMs,proto3" json:"time_limit_ms,omitempty"`
+	CompileTimeLimitMs    uint32 `protobuf:"varint,2,opt,name=compile_time_limit_ms,json=compileTimeLimitMs,proto3" json:"compile_time_limit_ms,omitempty"`
+	MemoryLimitMb         uint32 `protobuf:"varint,3,opt,name=memory_limit_mb,json=memoryLimitMb,proto3" json:"memory_limit_mb,omitempty"`
+	MaxTimeLimitMs        uint32 `protobuf:"varint,4,opt,name=max_time_limit_ms,json=maxTimeLimitMs,proto3" json:"max_time_limit_ms,omitempty"`
+	MaxCompileTimeLimitMs uint32 `protobuf:"varint,5,opt,name=max_compile_time_limit_ms,json=maxCompileTimeLimitMs,proto3" json:"max_compile_time_limit_ms,omitempty"`
+	MaxMemoryLimitMb      uint32 `protobuf:"varint,6,opt,name=max_memory_limit_mb,json=maxMemoryLimitMb,proto3" json:"max_memory_limit_mb,omitempty"`
 }
 
-func (x *GetCompileResultRequest) Reset() {
-	*x = GetCompileResultRequest{}
+func (x *LanguageLimits) Reset() {
+	*x = LanguageLimits{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_content_consumer_v1_consumer_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -435,13 +697,13 @@ func (x *GetCompileResultRequest) Reset() {
 	}
 }
 
-func (x *GetCompileResultRequest) String() string {
+func (x *LanguageLimits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompileResultRequest) ProtoMessage() {}
+func (*LanguageLimits) ProtoMessage() {}
 
-func (x *GetCompileResultRequest) ProtoReflect() protoreflect.Message {
+func (x *LanguageLimits) ProtoReflect() protoreflect.Message {
 	mi := &file_content_consumer_v1_consumer_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -453,63 +715,2688 @@ func (x *GetCompileResultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompileResultRequest.ProtoReflect.Descriptor instead.
-func (*GetCompileResultRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LanguageLimits.ProtoReflect.Descriptor instead.
+func (*LanguageLimits) Descriptor() ([]byte, []int) {
 	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetCompileResultRequest) GetId() string {
+func (x *LanguageLimits) GetTimeLimitMs() uint32 {
 	if x != nil {
-		return x.Id
+		return x.TimeLimitMs
 	}
-	return ""
+	return 0
 }
 
-// The details of a compile request.
-type GetCompileResultResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *LanguageLimits) GetCompileTimeLimitMs() uint32 {
+	if x != nil {
+		return x.CompileTimeLimitMs
+	}
+	return 0
+}
 
-	// The language which was used in to compile and execute request. This will
-	// match the request language.
+func (x *LanguageLimits) GetMemoryLimitMb() uint32 {
+	if x != nil {
+		return x.MemoryLimitMb
+	}
+	return 0
+}
+
+func (x *LanguageLimits) GetMaxTimeLimitMs() uint32 {
+	if x != nil {
+		return x.MaxTimeLimitMs
+	}
+	return 0
+}
+
+func (x *LanguageLimits) GetMaxCompileTimeLimitMs() uint32 {
+	if x != nil {
+		return x.MaxCompileTimeLimitMs
+	}
+	return 0
+}
+
+func (x *LanguageLimits) GetMaxMemoryLimitMb() uint32 {
+	if x != nil {
+		return x.MaxMemoryLimitMb
+	}
+	return 0
+}
+
+// Contains the list of supported languages currently.
+type GetSupportedLanguagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The list of supported languages within the system.
+	Languages []*SupportedLanguage `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"`
+}
+
+func (x *GetSupportedLanguagesResponse) Reset() {
+	*x = GetSupportedLanguagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSupportedLanguagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedLanguagesResponse) ProtoMessage() {}
+
+func (x *GetSupportedLanguagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedLanguagesResponse.ProtoReflect.Descriptor instead.
+func (*GetSupportedLanguagesResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetSupportedLanguagesResponse) GetLanguages() []*SupportedLanguage {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+// The request to compile and run code.
+type CreateCompileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The target language that is being sent. Incorrectly setting this will
+	// result in a faulted request.
 	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
-	// The resulting status of the entire request.
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	// The resulting test status, if a test was provided.
-	TestStatus string `protobuf:"bytes,3,opt,name=test_status,json=testStatus,proto3" json:"test_status,omitempty"`
-	// The total milliseconds taken to compile the request if it was not an
-	// interpreted language.
-	CompileMs int64 `protobuf:"varint,4,opt,name=compile_ms,json=compileMs,proto3" json:"compile_ms,omitempty"`
-	// The total milliseconds taken to run the code.
-	RuntimeMs int64 `protobuf:"varint,5,opt,name=runtime_ms,json=runtimeMs,proto3" json:"runtime_ms,omitempty"`
-	// The maximum  number of megabytes used to run the request.
-	RuntimeMemoryMb float64 `protobuf:"fixed64,6,opt,name=runtime_memory_mb,json=runtimeMemoryMb,proto3" json:"runtime_memory_mb,omitempty"`
-	// The raw output of the request.
-	Output string `protobuf:"bytes,7,opt,name=output,proto3" json:"output,omitempty"`
-	// The raw error output of the request.
-	OutputError string `protobuf:"bytes,8,opt,name=output_error,json=outputError,proto3" json:"output_error,omitempty"`
-	// The raw compile output of the request, if compiled.
-	CompilerOutput string `protobuf:"bytes,9,opt,name=compiler_output,json=compilerOutput,proto3" json:"compiler_output,omitempty"`
+	// The source code that will be executed, this should be well formatted as
+	// if it was ready to be compiled. Misconfigured ro formatted code will be
+	// rejected by the runtime or compiler. Either the source or the files must
+	// be provided.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// This array of strings will be written to the standard input of the code
+	// when executing. Each array item is a line which will be written one after
+	// another.
+	StandardInData []string `protobuf:"bytes,3,rep,name=standard_in_data,json=standardInData,proto3" json:"standard_in_data,omitempty"`
+	// This is an array of expected output data, including data here that will
+	// result in a validation check on completion. If no items are added to the
+	// array then the status endpoint will return NoTest for the test status.
+	// Otherwise, a value related to the test result.
+	ExpectedStandardOutData []string `protobuf:"bytes,4,rep,name=expected_standard_out_data,json=expectedStandardOutData,proto3" json:"expected_standard_out_data,omitempty"`
+	// The list of test cases the code will be executed against. The code will
+	// be compiled once and then executed once per test case, each with its own
+	// time and memory accounting. If provided, the standard_in_data and the
+	// expected_standard_out_data fields are ignored.
+	TestCases []*TestCase `protobuf:"bytes,5,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+	// The optional maximum number of milliseconds the code is allowed to run
+	// for, per test case. If not set the default of the environment is used.
+	// Requests exceeding the maximum of the environment are rejected.
+	TimeLimitMs uint32 `protobuf:"varint,6,opt,name=time_limit_ms,json=timeLimitMs,proto3" json:"time_limit_ms,omitempty"`
+	// The optional maximum number of milliseconds the code is allowed to
+	// compile for. If not set the default of the environment is used. Requests
+	// exceeding the maximum of the environment are rejected.
+	CompileTimeLimitMs uint32 `protobuf:"varint,7,opt,name=compile_time_limit_ms,json=compileTimeLimitMs,proto3" json:"compile_time_limit_ms,omitempty"`
+	// The optional maximum number of megabytes the code is allowed to use while
+	// running. If not set the default of the environment is used. Requests
+	// exceeding the maximum of the environment are rejected.
+	MemoryLimitMb uint32 `protobuf:"varint,8,opt,name=memory_limit_mb,json=memoryLimitMb,proto3" json:"memory_limit_mb,omitempty"`
+	// An optional key used to safely retry the request. Repeating a request with
+	// the same key within the retention window returns the id of the original
+	// request instead of creating a new one. While the original request is still
+	// in progress the request fails with ABORTED and should be retried.
+	IdempotencyKey string `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// An optional http or https url which will receive a POST request containing
+	// the final result once the execution has completed. The body is signed with
+	// HMAC-SHA256 and the signature provided in the X-Cars-Signature header.
+	CallbackUrl string `protobuf:"bytes,10,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	// The files of a multi-file submission, used instead of the source. Every
+	// file is written into the project directory and compiled together.
+	Files []*SourceFile `protobuf:"bytes,11,rep,name=files,proto3" json:"files,omitempty"`
+	// The path of the file containing the entry point of a multi-file
+	// submission, e.g. the file containing the main function. If not set the
+	// default source file name of the language is used, e.g. solution.py.
+	EntryFile string `protobuf:"bytes,12,opt,name=entry_file,json=entryFile,proto3" json:"entry_file,omitempty"`
+	// The id of a source previously uploaded with UploadSource, used instead of
+	// the source or the files. The files of the uploaded source are compiled
+	// together the same as a multi-file submission.
+	SourceId string `protobuf:"bytes,13,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// If the compiled binary should be collected as an artifact of the
+	// execution, only supported by languages compiling to a single binary.
+	IncludeBinary bool `protobuf:"varint,14,opt,name=include_binary,json=includeBinary,proto3" json:"include_binary,omitempty"`
+	// The optional comparison of the standard output with the expected standard
+	// output of each test case. If not set the output must match exactly.
+	Comparison *OutputComparison `protobuf:"bytes,15,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	// The optional checker program judging the output of each test case
+	// instead of the comparison, used when a test case has many valid outputs.
+	Checker *Checker `protobuf:"bytes,16,opt,name=checker,proto3" json:"checker,omitempty"`
+	// The optional interactor program of an interactive problem, running
+	// alongside the code for each test case instead of the standard input.
+	Interactor *Interactor `protobuf:"bytes,17,opt,name=interactor,proto3" json:"interactor,omitempty"`
+	// If the diff of each failed test case should include the unified diff of
+	// the expected and the actual output, alongside the first mismatch.
+	IncludeDiff bool `protobuf:"varint,18,opt,name=include_diff,json=includeDiff,proto3" json:"include_diff,omitempty"`
+	// The optional groups the test cases are scored in, e.g. the subtasks of a
+	// problem. When provided every test case must name one of the groups, and
+	// the result includes the score of each group and the total score.
+	TestGroups []*TestGroup `protobuf:"bytes,19,rep,name=test_groups,json=testGroups,proto3" json:"test_groups,omitempty"`
+}
+
+func (x *CreateCompileRequest) Reset() {
+	*x = CreateCompileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCompileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompileRequest) ProtoMessage() {}
+
+func (x *CreateCompileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompileRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompileRequest) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateCompileRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CreateCompileRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CreateCompileRequest) GetStandardInData() []string {
+	if x != nil {
+		return x.StandardInData
+	}
+	return nil
+}
+
+func (x *CreateCompileRequest) GetExpectedStandardOutData() []string {
+	if x != nil {
+		return x.ExpectedStandardOutData
+	}
+	return nil
+}
+
+func (x *CreateCompileRequest) GetTestCases() []*TestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+func (x *CreateCompileRequest) GetTimeLimitMs() uint32 {
+	if x != nil {
+		return x.TimeLimitMs
+	}
+	return 0
+}
+
+func (x *CreateCompileRequest) GetCompileTimeLimitMs() uint32 {
+	if x != nil {
+		return x.CompileTimeLimitMs
+	}
+	return 0
+}
+
+func (x *CreateCompileRequest) GetMemoryLimitMb() uint32 {
+	if x != nil {
+		return x.MemoryLimitMb
+	}
+	return 0
+}
+
+func (x *CreateCompileRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateCompileRequest) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *CreateCompileRequest) GetFiles() []*SourceFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *CreateCompileRequest) GetEntryFile() string {
+	if x != nil {
+		return x.EntryFile
+	}
+	return ""
+}
+
+func (x *CreateCompileRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *CreateCompileRequest) GetIncludeBinary() bool {
+	if x != nil {
+		return x.IncludeBinary
+	}
+	return false
+}
+
+func (x *CreateCompileRequest) GetComparison() *OutputComparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return nil
+}
+
+func (x *CreateCompileRequest) GetChecker() *Checker {
+	if x != nil {
+		return x.Checker
+	}
+	return nil
+}
+
+func (x *CreateCompileRequest) GetInteractor() *Interactor {
+	if x != nil {
+		return x.Interactor
+	}
+	return nil
+}
+
+func (x *CreateCompileRequest) GetIncludeDiff() bool {
+	if x != nil {
+		return x.IncludeDiff
+	}
+	return false
+}
+
+func (x *CreateCompileRequest) GetTestGroups() []*TestGroup {
+	if x != nil {
+		return x.TestGroups
+	}
+	return nil
+}
+
+// A named group of test cases scored together.
+type TestGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique name of the group, referenced by the test cases of the group
+	// and the groups depending on it. The name must only contain letters,
+	// digits, '.', '_' or '-'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The maximum score of the group, given when the group scored fully.
+	Weight float64 `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// The policy of scoring the group from the score of its test cases.
+	Policy ScoringPolicy `protobuf:"varint,3,opt,name=policy,proto3,enum=content.consumer.v1.ScoringPolicy" json:"policy,omitempty"`
+	// The names of the groups which must score fully for this group to be
+	// scored, otherwise the group scores nothing. Each must be declared before
+	// this group.
+	Dependencies []string `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
+
+func (x *TestGroup) Reset() {
+	*x = TestGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestGroup) ProtoMessage() {}
+
+func (x *TestGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestGroup.ProtoReflect.Descriptor instead.
+func (*TestGroup) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TestGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestGroup) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TestGroup) GetPolicy() ScoringPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return ScoringPolicy_SCORING_POLICY_UNSPECIFIED
+}
+
+func (x *TestGroup) GetDependencies() []string {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+// An interactor program running alongside the code, the standard output of
+// each is connected to the standard input of the other and everything
+// exchanged is saved as the transcript-<index>.txt artifact. Following the
+// testlib convention the interactor is executed with the paths of the input,
+// an output file and the expected output of the test case as its arguments,
+// and the exit code decides the verdict the same as a checker. The interactor
+// runs within the same image as the code, so must be written in a language
+// sharing the image of the language of the code, e.g. c and cpp. The code
+// runs as an unprivileged user and cannot read the files of the interactor.
+type Interactor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The language of the interactor.
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	// The source code of the interactor. The cpp image includes testlib.h.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *Interactor) Reset() {
+	*x = Interactor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interactor) ProtoMessage() {}
+
+func (x *Interactor) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interactor.ProtoReflect.Descriptor instead.
+func (*Interactor) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Interactor) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Interactor) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// A checker program judging the output of the code, written in any of the
+// supported languages and executed in its own sandbox once per test case.
+// Following the testlib convention the checker is executed with the paths of
+// the input, the output of the code and the expected output as its
+// arguments. The exit code determines the verdict, 0 accepts the output, 1, 2,
+// 4 and 8 reject the output and 7 gives a partial score from the points
+// written by quitp, clamped between 0 and 1. Exit codes 50 to 250 give a
+// partial score of (code - 50)%, which is the exit code of quitf(_pc(n)) only
+// when testlib is built for TESTSYS, i.e. TESTSYS is defined before including
+// testlib.h. Anything written to the standard error is returned as the
+// message.
+type Checker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The language of the checker.
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	// The source code of the checker. The cpp image includes testlib.h.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *Checker) Reset() {
+	*x = Checker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Checker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Checker) ProtoMessage() {}
+
+func (x *Checker) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Checker.ProtoReflect.Descriptor instead.
+func (*Checker) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Checker) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Checker) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// The comparison of the standard output with the expected standard output.
+type OutputComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The mode of the comparison.
+	Mode ComparisonMode `protobuf:"varint,1,opt,name=mode,proto3,enum=content.consumer.v1.ComparisonMode" json:"mode,omitempty"`
+	// The maximum absolute difference between two numbers, only used by
+	// COMPARISON_MODE_FLOAT, e.g. 1e-6.
+	AbsoluteEpsilon float64 `protobuf:"fixed64,2,opt,name=absolute_epsilon,json=absoluteEpsilon,proto3" json:"absolute_epsilon,omitempty"`
+	// The maximum difference between two numbers relative to the larger of the
+	// two, only used by COMPARISON_MODE_FLOAT, e.g. 1e-9.
+	RelativeEpsilon float64 `protobuf:"fixed64,3,opt,name=relative_epsilon,json=relativeEpsilon,proto3" json:"relative_epsilon,omitempty"`
+}
+
+func (x *OutputComparison) Reset() {
+	*x = OutputComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutputComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputComparison) ProtoMessage() {}
+
+func (x *OutputComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputComparison.ProtoReflect.Descriptor instead.
+func (*OutputComparison) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OutputComparison) GetMode() ComparisonMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ComparisonMode_COMPARISON_MODE_UNSPECIFIED
+}
+
+func (x *OutputComparison) GetAbsoluteEpsilon() float64 {
+	if x != nil {
+		return x.AbsoluteEpsilon
+	}
+	return 0
+}
+
+func (x *OutputComparison) GetRelativeEpsilon() float64 {
+	if x != nil {
+		return x.RelativeEpsilon
+	}
+	return 0
+}
+
+// A single file of a multi-file submission.
+type SourceFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path of the file relative to the project directory, e.g.
+	// "utils/math.c". The path must be relative, must not contain any ".."
+	// segments and must only contain letters, digits, '.', '_' or '-'.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The content of the file.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *SourceFile) Reset() {
+	*x = SourceFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceFile) ProtoMessage() {}
+
+func (x *SourceFile) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceFile.ProtoReflect.Descriptor instead.
+func (*SourceFile) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SourceFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SourceFile) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// A single test case the code will be executed against.
+type TestCase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// This array of strings will be written to the standard input of the code
+	// when executing. Each array item is a line which will be written one after
+	// another.
+	StandardInData []string `protobuf:"bytes,1,rep,name=standard_in_data,json=standardInData,proto3" json:"standard_in_data,omitempty"`
+	// This is an array of expected output data for the test case. If no items
+	// are added then the test case will return NoTest for the test status.
+	ExpectedStandardOutData []string `protobuf:"bytes,2,rep,name=expected_standard_out_data,json=expectedStandardOutData,proto3" json:"expected_standard_out_data,omitempty"`
+	// The name of the group the test case is scored in, required when test
+	// groups are provided and must be the name of one of the groups.
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *TestCase) Reset() {
+	*x = TestCase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCase) ProtoMessage() {}
+
+func (x *TestCase) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCase.ProtoReflect.Descriptor instead.
+func (*TestCase) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TestCase) GetStandardInData() []string {
+	if x != nil {
+		return x.StandardInData
+	}
+	return nil
+}
+
+func (x *TestCase) GetExpectedStandardOutData() []string {
+	if x != nil {
+		return x.ExpectedStandardOutData
+	}
+	return nil
+}
+
+func (x *TestCase) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// The response when requesting a compiled request via the queue.
+type CreateCompileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reference ID of the compile request. Use later to retrieve updated
+	// information regarding the state of the execution.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateCompileResponse) Reset() {
+	*x = CreateCompileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCompileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompileResponse) ProtoMessage() {}
+
+func (x *CreateCompileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompileResponse.ProtoReflect.Descriptor instead.
+func (*CreateCompileResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateCompileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request to compile and run code, waiting for the result.
+type CompileAndWaitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The compile request that will be executed.
+	Request *CreateCompileRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *CompileAndWaitRequest) Reset() {
+	*x = CompileAndWaitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompileAndWaitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompileAndWaitRequest) ProtoMessage() {}
+
+func (x *CompileAndWaitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompileAndWaitRequest.ProtoReflect.Descriptor instead.
+func (*CompileAndWaitRequest) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CompileAndWaitRequest) GetRequest() *CreateCompileRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// The response of compiling and waiting for the result.
+type CompileAndWaitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reference ID of the compile request. Use later to retrieve updated
+	// information if the request did not complete in time.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The status of the request when the response was returned.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// If the request completed before the deadline of the call. When false the
+	// result is not set and GetCompileResult should be used.
+	Completed bool `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	// The complete result of the request, only set if completed.
+	Result *GetCompileResultResponse `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CompileAndWaitResponse) Reset() {
+	*x = CompileAndWaitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompileAndWaitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompileAndWaitResponse) ProtoMessage() {}
+
+func (x *CompileAndWaitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompileAndWaitResponse.ProtoReflect.Descriptor instead.
+func (*CompileAndWaitResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompileAndWaitResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompileAndWaitResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CompileAndWaitResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *CompileAndWaitResponse) GetResult() *GetCompileResultResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Compile result request can be used to request updated information about the
+// state or result of the compile request.
+type GetCompileResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the request, this value would have been returned by the compile
+	// execution request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetCompileResultRequest) Reset() {
+	*x = GetCompileResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompileResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompileResultRequest) ProtoMessage() {}
+
+func (x *GetCompileResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompileResultRequest.ProtoReflect.Descriptor instead.
+func (*GetCompileResultRequest) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCompileResultRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The details of a compile request.
+type GetCompileResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The language which was used in to compile and execute request. This will
+	// match the request language.
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	// The resulting status of the entire request.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// The resulting test status, if a test was provided. This is the aggregate
+	// of all the test cases, failing if any of the test cases failed.
+	TestStatus string `protobuf:"bytes,3,opt,name=test_status,json=testStatus,proto3" json:"test_status,omitempty"`
+	// The total milliseconds taken to compile the request if it was not an
+	// interpreted language.
+	CompileMs int64 `protobuf:"varint,4,opt,name=compile_ms,json=compileMs,proto3" json:"compile_ms,omitempty"`
+	// The total milliseconds taken to run the code.
+	RuntimeMs int64 `protobuf:"varint,5,opt,name=runtime_ms,json=runtimeMs,proto3" json:"runtime_ms,omitempty"`
+	// The maximum  number of megabytes used to run the request.
+	RuntimeMemoryMb float64 `protobuf:"fixed64,6,opt,name=runtime_memory_mb,json=runtimeMemoryMb,proto3" json:"runtime_memory_mb,omitempty"`
+	// The raw output of the request.
+	Output string `protobuf:"bytes,7,opt,name=output,proto3" json:"output,omitempty"`
+	// The raw error output of the request.
+	OutputError string `protobuf:"bytes,8,opt,name=output_error,json=outputError,proto3" json:"output_error,omitempty"`
+	// The raw compile output of the request, if compiled.
+	CompilerOutput string `protobuf:"bytes,9,opt,name=compiler_output,json=compilerOutput,proto3" json:"compiler_output,omitempty"`
+	// The individual results of each test case, in the same order as the test
+	// cases were provided. A single result is returned if no test cases were
+	// provided.
+	TestCases []*TestCaseResult `protobuf:"bytes,10,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+	// The score of the groups of test cases out of the max score, the sum of
+	// the weights of the groups. Only set if test groups were provided.
+	Score float64 `protobuf:"fixed64,11,opt,name=score,proto3" json:"score,omitempty"`
+	// The maximum score of the groups of test cases.
+	MaxScore float64 `protobuf:"fixed64,12,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	// The individual results of each group of test cases, in the same order as
+	// the groups were provided.
+	TestGroups []*TestGroupResult `protobuf:"bytes,13,rep,name=test_groups,json=testGroups,proto3" json:"test_groups,omitempty"`
+}
+
+func (x *GetCompileResultResponse) Reset() {
+	*x = GetCompileResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompileResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompileResultResponse) ProtoMessage() {}
+
+func (x *GetCompileResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompileResultResponse.ProtoReflect.Descriptor instead.
+func (*GetCompileResultResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCompileResultResponse) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetCompileResultResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetCompileResultResponse) GetTestStatus() string {
+	if x != nil {
+		return x.TestStatus
+	}
+	return ""
+}
+
+func (x *GetCompileResultResponse) GetCompileMs() int64 {
+	if x != nil {
+		return x.CompileMs
+	}
+	return 0
+}
+
+func (x *GetCompileResultResponse) GetRuntimeMs() int64 {
+	if x != nil {
+		return x.RuntimeMs
+	}
+	return 0
+}
+
+func (x *GetCompileResultResponse) GetRuntimeMemoryMb() float64 {
+	if x != nil {
+		return x.RuntimeMemoryMb
+	}
+	return 0
+}
+
+func (x *GetCompileResultResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *GetCompileResultResponse) GetOutputError() string {
+	if x != nil {
+		return x.OutputError
+	}
+	return ""
+}
+
+func (x *GetCompileResultResponse) GetCompilerOutput() string {
+	if x != nil {
+		return x.CompilerOutput
+	}
+	return ""
+}
+
+func (x *GetCompileResultResponse) GetTestCases() []*TestCaseResult {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+func (x *GetCompileResultResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetCompileResultResponse) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *GetCompileResultResponse) GetTestGroups() []*TestGroupResult {
+	if x != nil {
+		return x.TestGroups
+	}
+	return nil
+}
+
+// The result of a single group of test cases.
+type TestGroupResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the group.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The resulting test status of the group, passed if the group scored fully,
+	// failed if it scored nothing and partial otherwise. TestNotRan if any of
+	// the dependencies of the group did not score fully.
+	TestStatus string `protobuf:"bytes,2,opt,name=test_status,json=testStatus,proto3" json:"test_status,omitempty"`
+	// The score given to the group out of its weight.
+	Score float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	// The weight of the group.
+	Weight float64 `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *TestGroupResult) Reset() {
+	*x = TestGroupResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestGroupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestGroupResult) ProtoMessage() {}
+
+func (x *TestGroupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestGroupResult.ProtoReflect.Descriptor instead.
+func (*TestGroupResult) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TestGroupResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestGroupResult) GetTestStatus() string {
+	if x != nil {
+		return x.TestStatus
+	}
+	return ""
+}
+
+func (x *TestGroupResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TestGroupResult) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// The result of a single test case execution.
+type TestCaseResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resulting status of the test case execution.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The resulting test status of the test case.
+	TestStatus string `protobuf:"bytes,2,opt,name=test_status,json=testStatus,proto3" json:"test_status,omitempty"`
+	// The total milliseconds taken to run the code for the test case.
+	RuntimeMs int64 `protobuf:"varint,3,opt,name=runtime_ms,json=runtimeMs,proto3" json:"runtime_ms,omitempty"`
+	// The maximum number of megabytes used to run the test case.
+	RuntimeMemoryMb float64 `protobuf:"fixed64,4,opt,name=runtime_memory_mb,json=runtimeMemoryMb,proto3" json:"runtime_memory_mb,omitempty"`
+	// The score of the test case between 0 and 1, the full score if the test
+	// passed or the score given by the checker.
+	Score float64 `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	// The message written by the checker or the interactor, if provided.
+	CheckerMessage string `protobuf:"bytes,6,opt,name=checker_message,json=checkerMessage,proto3" json:"checker_message,omitempty"`
+	// The total milliseconds taken by the interactor of the test case.
+	InteractorRuntimeMs int64 `protobuf:"varint,7,opt,name=interactor_runtime_ms,json=interactorRuntimeMs,proto3" json:"interactor_runtime_ms,omitempty"`
+	// The maximum number of megabytes used by the interactor of the test case.
+	InteractorRuntimeMemoryMb float64 `protobuf:"fixed64,8,opt,name=interactor_runtime_memory_mb,json=interactorRuntimeMemoryMb,proto3" json:"interactor_runtime_memory_mb,omitempty"`
+	// The diff of the output and the expected output if the test case failed.
+	Diff *OutputDiff `protobuf:"bytes,9,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *TestCaseResult) Reset() {
+	*x = TestCaseResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestCaseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCaseResult) ProtoMessage() {}
+
+func (x *TestCaseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCaseResult.ProtoReflect.Descriptor instead.
+func (*TestCaseResult) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TestCaseResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TestCaseResult) GetTestStatus() string {
+	if x != nil {
+		return x.TestStatus
+	}
+	return ""
+}
+
+func (x *TestCaseResult) GetRuntimeMs() int64 {
+	if x != nil {
+		return x.RuntimeMs
+	}
+	return 0
+}
+
+func (x *TestCaseResult) GetRuntimeMemoryMb() float64 {
+	if x != nil {
+		return x.RuntimeMemoryMb
+	}
+	return 0
+}
+
+func (x *TestCaseResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TestCaseResult) GetCheckerMessage() string {
+	if x != nil {
+		return x.CheckerMessage
+	}
+	return ""
+}
+
+func (x *TestCaseResult) GetInteractorRuntimeMs() int64 {
+	if x != nil {
+		return x.InteractorRuntimeMs
+	}
+	return 0
+}
+
+func (x *TestCaseResult) GetInteractorRuntimeMemoryMb() float64 {
+	if x != nil {
+		return x.InteractorRuntimeMemoryMb
+	}
+	return 0
+}
+
+func (x *TestCaseResult) GetDiff() *OutputDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+// The diff of the output of a failed test case and the expected output. The
+// output is compared the same as the comparison, e.g. the tokens are compared
+// when comparing tokens and the sorted lines are compared when comparing
+// unordered lines.
+type OutputDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The line of the first mismatch, starting at 1. Not set when comparing
+	// tokens or unordered lines.
+	Line int32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// The column of the first mismatch within the line, starting at 1. Not set
+	// when comparing tokens or unordered lines.
+	Column int32 `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	// The excerpt of the expected line of the first mismatch, starting shortly
+	// before the column and marked with "..." when truncated. The mismatching
+	// token when comparing tokens, or the first mismatching line once sorted
+	// when comparing unordered lines.
+	Expected string `protobuf:"bytes,3,opt,name=expected,proto3" json:"expected,omitempty"`
+	// The excerpt of the actual line of the first mismatch.
+	Actual string `protobuf:"bytes,4,opt,name=actual,proto3" json:"actual,omitempty"`
+	// The number of lines of the expected output.
+	ExpectedLines int32 `protobuf:"varint,5,opt,name=expected_lines,json=expectedLines,proto3" json:"expected_lines,omitempty"`
+	// The number of lines of the actual output.
+	ActualLines int32 `protobuf:"varint,6,opt,name=actual_lines,json=actualLines,proto3" json:"actual_lines,omitempty"`
+	// The unified diff of the expected and the actual output if requested,
+	// limited to 16KB and the first 1k lines of each. When comparing tokens the
+	// diff is of the tokens, one per line.
+	UnifiedDiff string `protobuf:"bytes,7,opt,name=unified_diff,json=unifiedDiff,proto3" json:"unified_diff,omitempty"`
+	// The index of the first mismatching token starting at 1, only set when
+	// comparing tokens.
+	Token int32 `protobuf:"varint,8,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *OutputDiff) Reset() {
+	*x = OutputDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutputDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputDiff) ProtoMessage() {}
+
+func (x *OutputDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputDiff.ProtoReflect.Descriptor instead.
+func (*OutputDiff) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OutputDiff) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *OutputDiff) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *OutputDiff) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *OutputDiff) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *OutputDiff) GetExpectedLines() int32 {
+	if x != nil {
+		return x.ExpectedLines
+	}
+	return 0
+}
+
+func (x *OutputDiff) GetActualLines() int32 {
+	if x != nil {
+		return x.ActualLines
+	}
+	return 0
+}
+
+func (x *OutputDiff) GetUnifiedDiff() string {
+	if x != nil {
+		return x.UnifiedDiff
+	}
+	return ""
+}
+
+func (x *OutputDiff) GetToken() int32 {
+	if x != nil {
+		return x.Token
+	}
+	return 0
+}
+
+// Watch compile result request is used to subscribe to the state changes of
+// the compile request until it has completed.
+type WatchCompileResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the request, this value would have been returned by the compile
+	// execution request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WatchCompileResultRequest) Reset() {
+	*x = WatchCompileResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCompileResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCompileResultRequest) ProtoMessage() {}
+
+func (x *WatchCompileResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCompileResultRequest.ProtoReflect.Descriptor instead.
+func (*WatchCompileResultRequest) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WatchCompileResultRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// A single state transition of a watched compile request.
+type WatchCompileResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current status of the entire request.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The current test status, if a test was provided.
+	TestStatus string `protobuf:"bytes,2,opt,name=test_status,json=testStatus,proto3" json:"test_status,omitempty"`
+	// The complete result of the request, this is only set on the final message
+	// of the stream once the request has reached a terminal status.
+	Result *GetCompileResultResponse `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *WatchCompileResultResponse) Reset() {
+	*x = WatchCompileResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCompileResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCompileResultResponse) ProtoMessage() {}
+
+func (x *WatchCompileResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCompileResultResponse.ProtoReflect.Descriptor instead.
+func (*WatchCompileResultResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WatchCompileResultResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WatchCompileResultResponse) GetTestStatus() string {
+	if x != nil {
+		return x.TestStatus
+	}
+	return ""
+}
+
+func (x *WatchCompileResultResponse) GetResult() *GetCompileResultResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Cancel compile request is used to abort a compile request which has not yet
+// completed.
+type CancelCompileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the request, this value would have been returned by the compile
+	// execution request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelCompileRequest) Reset() {
+	*x = CancelCompileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelCompileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCompileRequest) ProtoMessage() {}
+
+func (x *CancelCompileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCompileRequest.ProtoReflect.Descriptor instead.
+func (*CancelCompileRequest) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CancelCompileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The response after cancelling a compile request.
+type CancelCompileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resulting status of the entire request after being cancelled.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CancelCompileResponse) Reset() {
+	*x = CancelCompileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelCompileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCompileResponse) ProtoMessage() {}
+
+func (x *CancelCompileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCompileResponse.ProtoReflect.Descriptor instead.
+func (*CancelCompileResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CancelCompileResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// List executions request is used to page through the compile requests.
+type ListExecutionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only return executions of the given language.
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	// Only return executions with the given status.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Only return executions with the given test status.
+	TestStatus string `protobuf:"bytes,3,opt,name=test_status,json=testStatus,proto3" json:"test_status,omitempty"`
+	// Only return executions created at or after the given time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// Only return executions created before the given time.
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// The maximum number of executions to return, defaulting to 25.
+	PageSize int32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next page token returned by a previous call, used to continue listing
+	// from the end of the previous page.
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListExecutionsRequest) Reset() {
+	*x = ListExecutionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionsRequest) ProtoMessage() {}
+
+func (x *ListExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListExecutionsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ListExecutionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListExecutionsRequest) GetTestStatus() string {
+	if x != nil {
+		return x.TestStatus
+	}
+	return ""
+}
+
+func (x *ListExecutionsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListExecutionsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListExecutionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExecutionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// A summary of a single compile request.
+type ExecutionSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reference ID of the compile request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The language which was used in to compile and execute request.
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// The resulting status of the entire request.
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// The resulting test status, if a test was provided.
+	TestStatus string `protobuf:"bytes,4,opt,name=test_status,json=testStatus,proto3" json:"test_status,omitempty"`
+	// The total milliseconds taken to compile the request.
+	CompileMs int64 `protobuf:"varint,5,opt,name=compile_ms,json=compileMs,proto3" json:"compile_ms,omitempty"`
+	// The total milliseconds taken to run the code.
+	RuntimeMs int64 `protobuf:"varint,6,opt,name=runtime_ms,json=runtimeMs,proto3" json:"runtime_ms,omitempty"`
+	// The maximum  number of megabytes used to run the request.
+	RuntimeMemoryMb float64 `protobuf:"fixed64,7,opt,name=runtime_memory_mb,json=runtimeMemoryMb,proto3" json:"runtime_memory_mb,omitempty"`
+	// The time the compile request was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ExecutionSummary) Reset() {
+	*x = ExecutionSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionSummary) ProtoMessage() {}
+
+func (x *ExecutionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionSummary.ProtoReflect.Descriptor instead.
+func (*ExecutionSummary) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExecutionSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExecutionSummary) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ExecutionSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExecutionSummary) GetTestStatus() string {
+	if x != nil {
+		return x.TestStatus
+	}
+	return ""
+}
+
+func (x *ExecutionSummary) GetCompileMs() int64 {
+	if x != nil {
+		return x.CompileMs
+	}
+	return 0
+}
+
+func (x *ExecutionSummary) GetRuntimeMs() int64 {
+	if x != nil {
+		return x.RuntimeMs
+	}
+	return 0
+}
+
+func (x *ExecutionSummary) GetRuntimeMemoryMb() float64 {
+	if x != nil {
+		return x.RuntimeMemoryMb
+	}
+	return 0
+}
+
+func (x *ExecutionSummary) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// A single page of compile requests.
+type ListExecutionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The executions of the page, ordered by the newest first.
+	Executions []*ExecutionSummary `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	// The token used to request the following page, empty if this is the last
+	// page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListExecutionsResponse) Reset() {
+	*x = ListExecutionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionsResponse) ProtoMessage() {}
+
+func (x *ListExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListExecutionsResponse) GetExecutions() []*ExecutionSummary {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *ListExecutionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// The request to compile and run many requests as a single batch.
+type CreateCompileBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The compile requests of the batch. Idempotency keys are not supported
+	// within a batch.
+	Requests []*CreateCompileRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *CreateCompileBatchRequest) Reset() {
+	*x = CreateCompileBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCompileBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompileBatchRequest) ProtoMessage() {}
+
+func (x *CreateCompileBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompileBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompileBatchRequest) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateCompileBatchRequest) GetRequests() []*CreateCompileRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// The response after creating a batch of compile requests.
+type CreateCompileBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reference ID of the batch, used to retrieve the progress of the batch.
+	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The reference IDs of each compile request, in the same order as the
+	// requests were provided.
+	Ids []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	// The result of queueing each compile request, in the same order as the
+	// requests were provided.
+	Results []*CreateCompileBatchResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *CreateCompileBatchResponse) Reset() {
+	*x = CreateCompileBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCompileBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompileBatchResponse) ProtoMessage() {}
+
+func (x *CreateCompileBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompileBatchResponse.ProtoReflect.Descriptor instead.
+func (*CreateCompileBatchResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateCompileBatchResponse) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *CreateCompileBatchResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *CreateCompileBatchResponse) GetResults() []*CreateCompileBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// The result of queueing a single compile request of a batch.
+type CreateCompileBatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reference ID of the compile request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If the compile request was queued. A compile request which failed to be
+	// queued will not be executed and is given the NonDeterministicError status.
+	Queued bool `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	// The reason the compile request failed to be queued.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CreateCompileBatchResult) Reset() {
+	*x = CreateCompileBatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCompileBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompileBatchResult) ProtoMessage() {}
+
+func (x *CreateCompileBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompileBatchResult.ProtoReflect.Descriptor instead.
+func (*CreateCompileBatchResult) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateCompileBatchResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateCompileBatchResult) GetQueued() bool {
+	if x != nil {
+		return x.Queued
+	}
+	return false
+}
+
+func (x *CreateCompileBatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Get batch result request is used to request the progress of a batch.
+type GetBatchResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the batch, this value would have been returned by the create
+	// compile batch request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetBatchResultRequest) Reset() {
+	*x = GetBatchResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchResultRequest) ProtoMessage() {}
+
+func (x *GetBatchResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchResultRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchResultRequest) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetBatchResultRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The number of executions with a given status.
+type StatusCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The status of the executions.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The number of executions with the status.
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *StatusCount) Reset() {
+	*x = StatusCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusCount) ProtoMessage() {}
+
+func (x *StatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusCount.ProtoReflect.Descriptor instead.
+func (*StatusCount) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StatusCount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// The progress of a batch of compile requests.
+type GetBatchResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The total number of compile requests within the batch.
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// The number of compile requests which have completed.
+	Completed int64 `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	// The percentage of compile requests which have completed, between 0 and 100.
+	CompletionPercentage float64 `protobuf:"fixed64,3,opt,name=completion_percentage,json=completionPercentage,proto3" json:"completion_percentage,omitempty"`
+	// The number of compile requests by their status.
+	StatusCounts []*StatusCount `protobuf:"bytes,4,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty"`
+	// The number of compile requests by their test status.
+	TestStatusCounts []*StatusCount `protobuf:"bytes,5,rep,name=test_status_counts,json=testStatusCounts,proto3" json:"test_status_counts,omitempty"`
+}
+
+func (x *GetBatchResultResponse) Reset() {
+	*x = GetBatchResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchResultResponse) ProtoMessage() {}
+
+func (x *GetBatchResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchResultResponse.ProtoReflect.Descriptor instead.
+func (*GetBatchResultResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetBatchResultResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetBatchResultResponse) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *GetBatchResultResponse) GetCompletionPercentage() float64 {
+	if x != nil {
+		return x.CompletionPercentage
+	}
+	return 0
+}
+
+func (x *GetBatchResultResponse) GetStatusCounts() []*StatusCount {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+func (x *GetBatchResultResponse) GetTestStatusCounts() []*StatusCount {
+	if x != nil {
+		return x.TestStatusCounts
+	}
+	return nil
+}
+
+// A single message of the upload source stream.
+type UploadSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*UploadSourceRequest_Metadata
+	//	*UploadSourceRequest_Chunk
+	Data isUploadSourceRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *UploadSourceRequest) Reset() {
+	*x = UploadSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSourceRequest) ProtoMessage() {}
+
+func (x *UploadSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSourceRequest.ProtoReflect.Descriptor instead.
+func (*UploadSourceRequest) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{37}
+}
+
+func (m *UploadSourceRequest) GetData() isUploadSourceRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UploadSourceRequest) GetMetadata() *UploadSourceMetadata {
+	if x, ok := x.GetData().(*UploadSourceRequest_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UploadSourceRequest) GetChunk() []byte {
+	if x, ok := x.GetData().(*UploadSourceRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isUploadSourceRequest_Data interface {
+	isUploadSourceRequest_Data()
+}
+
+type UploadSourceRequest_Metadata struct {
+	// The metadata of the source, which must be the first message.
+	Metadata *UploadSourceMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadSourceRequest_Chunk struct {
+	// A chunk of the bytes of the source.
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadSourceRequest_Metadata) isUploadSourceRequest_Data() {}
+
+func (*UploadSourceRequest_Chunk) isUploadSourceRequest_Data() {}
+
+// The metadata of the uploaded source.
+type UploadSourceMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The format of the uploaded bytes.
+	Format SourceFormat `protobuf:"varint,1,opt,name=format,proto3,enum=content.consumer.v1.SourceFormat" json:"format,omitempty"`
+	// The path of the file when uploading a plain source, e.g. "solution.py".
+	// Ignored for archives, which contain the path of every file.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *UploadSourceMetadata) Reset() {
+	*x = UploadSourceMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadSourceMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSourceMetadata) ProtoMessage() {}
+
+func (x *UploadSourceMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSourceMetadata.ProtoReflect.Descriptor instead.
+func (*UploadSourceMetadata) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UploadSourceMetadata) GetFormat() SourceFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SourceFormat_SOURCE_FORMAT_UNSPECIFIED
+}
+
+func (x *UploadSourceMetadata) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// The response once the source has been uploaded.
+type UploadSourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the source, used by CreateCompile to compile the source.
+	SourceId string `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// The paths of every file of the source.
+	Files []string `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	// The total number of bytes of the files of the source.
+	SizeBytes int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *UploadSourceResponse) Reset() {
+	*x = UploadSourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSourceResponse) ProtoMessage() {}
+
+func (x *UploadSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSourceResponse.ProtoReflect.Descriptor instead.
+func (*UploadSourceResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UploadSourceResponse) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *UploadSourceResponse) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *UploadSourceResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+// The request to list the artifacts of a compile request.
+type ListArtifactsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the request, this value would have been returned by the compile
+	// execution request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListArtifactsRequest) Reset() {
+	*x = ListArtifactsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListArtifactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArtifactsRequest) ProtoMessage() {}
+
+func (x *ListArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*ListArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListArtifactsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The artifacts of the compile request.
+type ListArtifactsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Every artifact of the compile request ordered by the path.
+	Artifacts []*Artifact `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+}
+
+func (x *ListArtifactsResponse) Reset() {
+	*x = ListArtifactsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArtifactsResponse) ProtoMessage() {}
+
+func (x *ListArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *GetCompileResultResponse) Reset() {
-	*x = GetCompileResultResponse{}
+// Deprecated: Use ListArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*ListArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListArtifactsResponse) GetArtifacts() []*Artifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+// A file collected from the artifacts directory once the code has run.
+type Artifact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path of the file relative to the artifacts directory.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The size of the file in bytes.
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *Artifact) Reset() {
+	*x = Artifact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_content_consumer_v1_consumer_proto_msgTypes[8]
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCompileResultResponse) String() string {
+func (x *Artifact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompileResultResponse) ProtoMessage() {}
+func (*Artifact) ProtoMessage() {}
 
-func (x *GetCompileResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_content_consumer_v1_consumer_proto_msgTypes[8]
+func (x *Artifact) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,72 +3407,131 @@ func (x *GetCompileResultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompileResultResponse.ProtoReflect.Descriptor instead.
-func (*GetCompileResultResponse) Descriptor() ([]byte, []int) {
-	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetCompileResultResponse) GetLanguage() string {
+func (x *Artifact) GetPath() string {
 	if x != nil {
-		return x.Language
+		return x.Path
 	}
 	return ""
 }
 
-func (x *GetCompileResultResponse) GetStatus() string {
+func (x *Artifact) GetSizeBytes() int64 {
 	if x != nil {
-		return x.Status
+		return x.SizeBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCompileResultResponse) GetTestStatus() string {
-	if x != nil {
-		return x.TestStatus
-	}
-	return ""
+// The request to download a single artifact of a compile request.
+type DownloadArtifactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the request, this value would have been returned by the compile
+	// execution request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The path of the artifact as returned by ListArtifacts.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *GetCompileResultResponse) GetCompileMs() int64 {
-	if x != nil {
-		return x.CompileMs
+func (x *DownloadArtifactRequest) Reset() {
+	*x = DownloadArtifactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetCompileResultResponse) GetRuntimeMs() int64 {
-	if x != nil {
-		return x.RuntimeMs
-	}
-	return 0
+func (x *DownloadArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetCompileResultResponse) GetRuntimeMemoryMb() float64 {
-	if x != nil {
-		return x.RuntimeMemoryMb
+func (*DownloadArtifactRequest) ProtoMessage() {}
+
+func (x *DownloadArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetCompileResultResponse) GetOutput() string {
+// Deprecated: Use DownloadArtifactRequest.ProtoReflect.Descriptor instead.
+func (*DownloadArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DownloadArtifactRequest) GetId() string {
 	if x != nil {
-		return x.Output
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetCompileResultResponse) GetOutputError() string {
+func (x *DownloadArtifactRequest) GetPath() string {
 	if x != nil {
-		return x.OutputError
+		return x.Path
 	}
 	return ""
 }
 
-func (x *GetCompileResultResponse) GetCompilerOutput() string {
+// A chunk of the content of the artifact.
+type DownloadArtifactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The next chunk of the bytes of the artifact.
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *DownloadArtifactResponse) Reset() {
+	*x = DownloadArtifactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_content_consumer_v1_consumer_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadArtifactResponse) ProtoMessage() {}
+
+func (x *DownloadArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_consumer_v1_consumer_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadArtifactResponse.ProtoReflect.Descriptor instead.
+func (*DownloadArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_content_consumer_v1_consumer_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DownloadArtifactResponse) GetChunk() []byte {
 	if x != nil {
-		return x.CompilerOutput
+		return x.Chunk
 	}
-	return ""
+	return nil
 }
 
 var File_content_consumer_v1_consumer_proto protoreflect.FileDescriptor
@@ -596,36 +3542,103 @@ var file_content_consumer_v1_consumer_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f,
 	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x7a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x5e, 0xfa, 0x42, 0x5b, 0x72, 0x59, 0x52, 0x07, 0x70, 0x79, 0x74, 0x68, 0x6f,
-	0x6e, 0x32, 0x52, 0x06, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
-	0x52, 0x04, 0x72, 0x75, 0x73, 0x74, 0x52, 0x04, 0x72, 0x75, 0x62, 0x79, 0x52, 0x02, 0x67, 0x6f,
-	0x52, 0x01, 0x63, 0x52, 0x03, 0x63, 0x70, 0x70, 0x52, 0x06, 0x66, 0x73, 0x68, 0x61, 0x72, 0x70,
-	0x52, 0x06, 0x63, 0x73, 0x68, 0x61, 0x72, 0x70, 0x52, 0x04, 0x6a, 0x61, 0x76, 0x61, 0x52, 0x06,
-	0x6b, 0x6f, 0x74, 0x6c, 0x69, 0x6e, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x52, 0x03, 0x70,
-	0x68, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x31, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22,
-	0x5b, 0x0a, 0x11, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x65, 0x0a, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x7a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x5e, 0xfa, 0x42, 0x5b, 0x72, 0x59, 0x52, 0x07, 0x70, 0x79, 0x74, 0x68,
+	0x6f, 0x6e, 0x32, 0x52, 0x06, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x72, 0x75, 0x73, 0x74, 0x52, 0x04, 0x72, 0x75, 0x62, 0x79, 0x52, 0x02, 0x67,
+	0x6f, 0x52, 0x01, 0x63, 0x52, 0x03, 0x63, 0x70, 0x70, 0x52, 0x06, 0x66, 0x73, 0x68, 0x61, 0x72,
+	0x70, 0x52, 0x06, 0x63, 0x73, 0x68, 0x61, 0x72, 0x70, 0x52, 0x04, 0x6a, 0x61, 0x76, 0x61, 0x52,
+	0x06, 0x6b, 0x6f, 0x74, 0x6c, 0x69, 0x6e, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x52, 0x03,
+	0x70, 0x68, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a,
+	0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20,
+	0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x18, 0x40, 0x32, 0x14, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x2a, 0x24, 0xd0, 0x01, 0x01,
+	0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x31, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x95, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7d, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x61, 0xfa, 0x42, 0x5e, 0x72, 0x5c, 0x52, 0x07,
+	0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x32, 0x52, 0x06, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x72, 0x75, 0x73, 0x74, 0x52, 0x04, 0x72, 0x75, 0x62,
+	0x79, 0x52, 0x02, 0x67, 0x6f, 0x52, 0x01, 0x63, 0x52, 0x03, 0x63, 0x70, 0x70, 0x52, 0x06, 0x66,
+	0x73, 0x68, 0x61, 0x72, 0x70, 0x52, 0x06, 0x63, 0x73, 0x68, 0x61, 0x72, 0x70, 0x52, 0x04, 0x6a,
+	0x61, 0x76, 0x61, 0x52, 0x06, 0x6b, 0x6f, 0x74, 0x6c, 0x69, 0x6e, 0x52, 0x05, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x52, 0x03, 0x70, 0x68, 0x70, 0xd0, 0x01, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
+	0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x22, 0x47, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0xc9, 0x03, 0x0a, 0x11, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x75, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x3b, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2d,
+	0x0a, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa3, 0x02, 0x0a, 0x0e, 0x4c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x12, 0x31, 0x0a,
+	0x15, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x6d, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x62, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x4d, 0x73, 0x12, 0x38, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x12, 0x2d, 0x0a,
+	0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x5f, 0x6d, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x62, 0x22, 0x65, 0x0a, 0x1d,
 	0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67,
 	0x75, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
 	0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75,
 	0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
 	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x73, 0x22, 0x9d, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x67, 0x65, 0x73, 0x22, 0xb6, 0x08, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
 	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7a, 0x0a, 0x08,
 	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5e,
 	0xfa, 0x42, 0x5b, 0x72, 0x59, 0x52, 0x07, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x32, 0x52, 0x06,
@@ -634,41 +3647,421 @@ var file_content_consumer_v1_consumer_proto_rawDesc = []byte{
 	0x03, 0x63, 0x70, 0x70, 0x52, 0x06, 0x66, 0x73, 0x68, 0x61, 0x72, 0x70, 0x52, 0x06, 0x63, 0x73,
 	0x68, 0x61, 0x72, 0x70, 0x52, 0x04, 0x6a, 0x61, 0x76, 0x61, 0x52, 0x06, 0x6b, 0x6f, 0x74, 0x6c,
 	0x69, 0x6e, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x52, 0x03, 0x70, 0x68, 0x70, 0x52, 0x08,
-	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10,
-	0x05, 0x18, 0x80, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10,
-	0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64,
-	0x49, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x4f, 0x75, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-	0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbd, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
-	0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x70, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x62, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x4d, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27,
-	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
-	0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x32, 0xfe, 0x03, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xfa, 0x42, 0x0a, 0x72, 0x08, 0x10,
+	0x05, 0x18, 0x80, 0x08, 0xd0, 0x01, 0x01, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x61, 0x72, 0x64, 0x49, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x4f,
+	0x75, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63,
+	0x61, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01,
+	0x02, 0x10, 0x32, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x22,
+	0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x4d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x62, 0x12, 0x31, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x12, 0x31, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x72, 0x09, 0x18, 0x80, 0x10,
+	0xd0, 0x01, 0x01, 0x88, 0x01, 0x01, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x55, 0x72, 0x6c, 0x12, 0x3f, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
+	0xff, 0x01, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x0a,
+	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01, 0x01, 0xb0, 0x01, 0x01, 0x52, 0x08, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x45,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x72, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x3f, 0x0a,
+	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x69, 0x66,
+	0x66, 0x12, 0x49, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x32,
+	0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xf4, 0x01, 0x0a,
+	0x09, 0x54, 0x65, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xfa, 0x42, 0x19, 0x72, 0x17, 0x10,
+	0x01, 0x18, 0x40, 0x32, 0x11, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x2e, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42,
+	0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x47, 0x0a, 0x0c, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x23, 0xfa, 0x42, 0x20, 0x92, 0x01, 0x1d, 0x10, 0x32, 0x22, 0x19, 0x72, 0x17, 0x10, 0x01,
+	0x18, 0x40, 0x32, 0x11, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x55, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xfa, 0x42, 0x36, 0x72, 0x34, 0x52, 0x07, 0x70, 0x79, 0x74,
+	0x68, 0x6f, 0x6e, 0x32, 0x52, 0x06, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x72, 0x75, 0x73, 0x74, 0x52, 0x04, 0x72, 0x75, 0x62, 0x79, 0x52, 0x02,
+	0x67, 0x6f, 0x52, 0x01, 0x63, 0x52, 0x03, 0x63, 0x70, 0x70, 0x52, 0x03, 0x70, 0x68, 0x70, 0x52,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06,
+	0x10, 0x05, 0x18, 0x80, 0x80, 0x04, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xaa,
+	0x01, 0x0a, 0x07, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x7a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5e, 0xfa, 0x42,
+	0x5b, 0x72, 0x59, 0x52, 0x07, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x32, 0x52, 0x06, 0x70, 0x79,
+	0x74, 0x68, 0x6f, 0x6e, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x72, 0x75, 0x73, 0x74,
+	0x52, 0x04, 0x72, 0x75, 0x62, 0x79, 0x52, 0x02, 0x67, 0x6f, 0x52, 0x01, 0x63, 0x52, 0x03, 0x63,
+	0x70, 0x70, 0x52, 0x06, 0x66, 0x73, 0x68, 0x61, 0x72, 0x70, 0x52, 0x06, 0x63, 0x73, 0x68, 0x61,
+	0x72, 0x70, 0x52, 0x04, 0x6a, 0x61, 0x76, 0x61, 0x52, 0x06, 0x6b, 0x6f, 0x74, 0x6c, 0x69, 0x6e,
+	0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x52, 0x03, 0x70, 0x68, 0x70, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0x10, 0x05, 0x18,
+	0x80, 0x80, 0x04, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x10,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x12, 0x41, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x4d,
+	0x6f, 0x64, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x10, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f,
+	0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa,
+	0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0f, 0x61,
+	0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x45, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x39,
+	0x0a, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x70, 0x73, 0x69, 0x6c,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x45, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0a, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff,
+	0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x18,
+	0x80, 0x80, 0x04, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa3, 0x01, 0x0a,
+	0x08, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x4f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x30, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x18, 0x40, 0x32, 0x11, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x15, 0x43,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x41,
+	0x6e, 0x64, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x29, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xfb, 0x03, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63,
+	0x61, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x09, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x45, 0x0a,
+	0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0x74, 0x0a, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xfd, 0x02, 0x0a, 0x0e, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x62, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x4d, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x5f, 0x6d, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x62, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0xef, 0x01, 0x0a, 0x0a, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x4c, 0x69,
+	0x6e, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x64,
+	0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2b, 0x0a, 0x19,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x1a, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x45, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x2f, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x9a, 0x03, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7d, 0x0a, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x61, 0xfa,
+	0x42, 0x5e, 0x72, 0x5c, 0x52, 0x07, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x32, 0x52, 0x06, 0x70,
+	0x79, 0x74, 0x68, 0x6f, 0x6e, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x72, 0x75, 0x73,
+	0x74, 0x52, 0x04, 0x72, 0x75, 0x62, 0x79, 0x52, 0x02, 0x67, 0x6f, 0x52, 0x01, 0x63, 0x52, 0x03,
+	0x63, 0x70, 0x70, 0x52, 0x06, 0x66, 0x73, 0x68, 0x61, 0x72, 0x70, 0x52, 0x06, 0x63, 0x73, 0x68,
+	0x61, 0x72, 0x70, 0x52, 0x04, 0x6a, 0x61, 0x76, 0x61, 0x52, 0x06, 0x6b, 0x6f, 0x74, 0x6c, 0x69,
+	0x6e, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x52, 0x03, 0x70, 0x68, 0x70, 0xd0, 0x01, 0x01,
+	0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a,
+	0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9c,
+	0x02, 0x0a, 0x10, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x62, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x87, 0x01,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6f, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xf4, 0x03, 0x52, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x58, 0x0a,
+	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x3b, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x98, 0x02,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x15,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x45, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x12, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x10, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x47, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x42, 0x0b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x7b,
+	0x0a, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01,
+	0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x68, 0x0a, 0x14, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x54, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x73, 0x22, 0x3d, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x22, 0x49, 0x0a, 0x17, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x30, 0x0a,
+	0x18, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2a,
+	0x8b, 0x01, 0x0a, 0x0d, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x48, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x52, 0x54, 0x49, 0x4f,
+	0x4e, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4d, 0x49, 0x4e, 0x10, 0x03, 0x2a, 0xfe, 0x01,
+	0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x52, 0x49, 0x53, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x52, 0x49, 0x53, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x01, 0x12, 0x2e, 0x0a, 0x2a,
+	0x43, 0x4f, 0x4d, 0x50, 0x41, 0x52, 0x49, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x5f,
+	0x57, 0x48, 0x49, 0x54, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16,
+	0x43, 0x4f, 0x4d, 0x50, 0x41, 0x52, 0x49, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x53, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f, 0x4d, 0x50,
+	0x41, 0x52, 0x49, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x41, 0x53, 0x45,
+	0x5f, 0x49, 0x4e, 0x53, 0x45, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x56, 0x45, 0x10, 0x04, 0x12, 0x19,
+	0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x52, 0x49, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10, 0x05, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x4d,
+	0x50, 0x41, 0x52, 0x49, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x45, 0x44, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x53, 0x10, 0x06, 0x2a, 0x77,
+	0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d,
+	0x0a, 0x19, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a,
+	0x13, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50,
+	0x4c, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x5a, 0x49, 0x50, 0x10, 0x02, 0x12, 0x18, 0x0a,
+	0x14, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54,
+	0x41, 0x52, 0x5f, 0x47, 0x5a, 0x10, 0x03, 0x32, 0xd5, 0x0c, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73,
 	0x75, 0x6d, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x50,
 	0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x63, 0x6f,
@@ -680,31 +4073,100 @@ var file_content_consumer_v1_consumer_proto_rawDesc = []byte{
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
 	0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
 	0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e,
-	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x2e, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6b, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x57, 0x61, 0x69,
+	0x74, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x41,
+	0x6e, 0x64, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x57, 0x61,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x10,
 	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x35, 0x5a, 0x33, 0x63, 0x6f, 0x6d, 0x70,
-	0x69, 0x6c, 0x65, 0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x72, 0x75, 0x6e, 0x2d, 0x73, 0x61, 0x6e, 0x64,
-	0x62, 0x6f, 0x78, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x79, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x68, 0x0a, 0x0d, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x77, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
+	0x12, 0x68, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x73, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x10, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x2c,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42,
+	0x35, 0x5a, 0x33, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x72,
+	0x75, 0x6e, 0x2d, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -719,36 +4181,125 @@ func file_content_consumer_v1_consumer_proto_rawDescGZIP() []byte {
 	return file_content_consumer_v1_consumer_proto_rawDescData
 }
 
-var file_content_consumer_v1_consumer_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_content_consumer_v1_consumer_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_content_consumer_v1_consumer_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_content_consumer_v1_consumer_proto_goTypes = []interface{}{
-	(*PingResponse)(nil),                  // 0: content.consumer.v1.PingResponse
-	(*GetTemplateRequest)(nil),            // 1: content.consumer.v1.GetTemplateRequest
-	(*GetTemplateResponse)(nil),           // 2: content.consumer.v1.GetTemplateResponse
-	(*SupportedLanguage)(nil),             // 3: content.consumer.v1.SupportedLanguage
-	(*GetSupportedLanguagesResponse)(nil), // 4: content.consumer.v1.GetSupportedLanguagesResponse
-	(*CreateCompileRequest)(nil),          // 5: content.consumer.v1.CreateCompileRequest
-	(*CreateCompileResponse)(nil),         // 6: content.consumer.v1.CreateCompileResponse
-	(*GetCompileResultRequest)(nil),       // 7: content.consumer.v1.GetCompileResultRequest
-	(*GetCompileResultResponse)(nil),      // 8: content.consumer.v1.GetCompileResultResponse
-	(*emptypb.Empty)(nil),                 // 9: google.protobuf.Empty
+	(ScoringPolicy)(0),                    // 0: content.consumer.v1.ScoringPolicy
+	(ComparisonMode)(0),                   // 1: content.consumer.v1.ComparisonMode
+	(SourceFormat)(0),                     // 2: content.consumer.v1.SourceFormat
+	(*PingResponse)(nil),                  // 3: content.consumer.v1.PingResponse
+	(*GetTemplateRequest)(nil),            // 4: content.consumer.v1.GetTemplateRequest
+	(*GetTemplateResponse)(nil),           // 5: content.consumer.v1.GetTemplateResponse
+	(*ListTemplatesRequest)(nil),          // 6: content.consumer.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),         // 7: content.consumer.v1.ListTemplatesResponse
+	(*TemplateVariant)(nil),               // 8: content.consumer.v1.TemplateVariant
+	(*SupportedLanguage)(nil),             // 9: content.consumer.v1.SupportedLanguage
+	(*LanguageLimits)(nil),                // 10: content.consumer.v1.LanguageLimits
+	(*GetSupportedLanguagesResponse)(nil), // 11: content.consumer.v1.GetSupportedLanguagesResponse
+	(*CreateCompileRequest)(nil),          // 12: content.consumer.v1.CreateCompileRequest
+	(*TestGroup)(nil),                     // 13: content.consumer.v1.TestGroup
+	(*Interactor)(nil),                    // 14: content.consumer.v1.Interactor
+	(*Checker)(nil),                       // 15: content.consumer.v1.Checker
+	(*OutputComparison)(nil),              // 16: content.consumer.v1.OutputComparison
+	(*SourceFile)(nil),                    // 17: content.consumer.v1.SourceFile
+	(*TestCase)(nil),                      // 18: content.consumer.v1.TestCase
+	(*CreateCompileResponse)(nil),         // 19: content.consumer.v1.CreateCompileResponse
+	(*CompileAndWaitRequest)(nil),         // 20: content.consumer.v1.CompileAndWaitRequest
+	(*CompileAndWaitResponse)(nil),        // 21: content.consumer.v1.CompileAndWaitResponse
+	(*GetCompileResultRequest)(nil),       // 22: content.consumer.v1.GetCompileResultRequest
+	(*GetCompileResultResponse)(nil),      // 23: content.consumer.v1.GetCompileResultResponse
+	(*TestGroupResult)(nil),               // 24: content.consumer.v1.TestGroupResult
+	(*TestCaseResult)(nil),                // 25: content.consumer.v1.TestCaseResult
+	(*OutputDiff)(nil),                    // 26: content.consumer.v1.OutputDiff
+	(*WatchCompileResultRequest)(nil),     // 27: content.consumer.v1.WatchCompileResultRequest
+	(*WatchCompileResultResponse)(nil),    // 28: content.consumer.v1.WatchCompileResultResponse
+	(*CancelCompileRequest)(nil),          // 29: content.consumer.v1.CancelCompileRequest
+	(*CancelCompileResponse)(nil),         // 30: content.consumer.v1.CancelCompileResponse
+	(*ListExecutionsRequest)(nil),         // 31: content.consumer.v1.ListExecutionsRequest
+	(*ExecutionSummary)(nil),              // 32: content.consumer.v1.ExecutionSummary
+	(*ListExecutionsResponse)(nil),        // 33: content.consumer.v1.ListExecutionsResponse
+	(*CreateCompileBatchRequest)(nil),     // 34: content.consumer.v1.CreateCompileBatchRequest
+	(*CreateCompileBatchResponse)(nil),    // 35: content.consumer.v1.CreateCompileBatchResponse
+	(*CreateCompileBatchResult)(nil),      // 36: content.consumer.v1.CreateCompileBatchResult
+	(*GetBatchResultRequest)(nil),         // 37: content.consumer.v1.GetBatchResultRequest
+	(*StatusCount)(nil),                   // 38: content.consumer.v1.StatusCount
+	(*GetBatchResultResponse)(nil),        // 39: content.consumer.v1.GetBatchResultResponse
+	(*UploadSourceRequest)(nil),           // 40: content.consumer.v1.UploadSourceRequest
+	(*UploadSourceMetadata)(nil),          // 41: content.consumer.v1.UploadSourceMetadata
+	(*UploadSourceResponse)(nil),          // 42: content.consumer.v1.UploadSourceResponse
+	(*ListArtifactsRequest)(nil),          // 43: content.consumer.v1.ListArtifactsRequest
+	(*ListArtifactsResponse)(nil),         // 44: content.consumer.v1.ListArtifactsResponse
+	(*Artifact)(nil),                      // 45: content.consumer.v1.Artifact
+	(*DownloadArtifactRequest)(nil),       // 46: content.consumer.v1.DownloadArtifactRequest
+	(*DownloadArtifactResponse)(nil),      // 47: content.consumer.v1.DownloadArtifactResponse
+	(*timestamppb.Timestamp)(nil),         // 48: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 49: google.protobuf.Empty
 }
 var file_content_consumer_v1_consumer_proto_depIdxs = []int32{
-	3, // 0: content.consumer.v1.GetSupportedLanguagesResponse.languages:type_name -> content.consumer.v1.SupportedLanguage
-	9, // 1: content.consumer.v1.ConsumerService.Ping:input_type -> google.protobuf.Empty
-	1, // 2: content.consumer.v1.ConsumerService.GetTemplate:input_type -> content.consumer.v1.GetTemplateRequest
-	9, // 3: content.consumer.v1.ConsumerService.GetSupportedLanguages:input_type -> google.protobuf.Empty
-	5, // 4: content.consumer.v1.ConsumerService.CreateCompile:input_type -> content.consumer.v1.CreateCompileRequest
-	7, // 5: content.consumer.v1.ConsumerService.GetCompileResult:input_type -> content.consumer.v1.GetCompileResultRequest
-	0, // 6: content.consumer.v1.ConsumerService.Ping:output_type -> content.consumer.v1.PingResponse
-	2, // 7: content.consumer.v1.ConsumerService.GetTemplate:output_type -> content.consumer.v1.GetTemplateResponse
-	4, // 8: content.consumer.v1.ConsumerService.GetSupportedLanguages:output_type -> content.consumer.v1.GetSupportedLanguagesResponse
-	6, // 9: content.consumer.v1.ConsumerService.CreateCompile:output_type -> content.consumer.v1.CreateCompileResponse
-	8, // 10: content.consumer.v1.ConsumerService.GetCompileResult:output_type -> content.consumer.v1.GetCompileResultResponse
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8,  // 0: content.consumer.v1.ListTemplatesResponse.templates:type_name -> content.consumer.v1.TemplateVariant
+	10, // 1: content.consumer.v1.SupportedLanguage.limits:type_name -> content.consumer.v1.LanguageLimits
+	48, // 2: content.consumer.v1.SupportedLanguage.checked_at:type_name -> google.protobuf.Timestamp
+	9,  // 3: content.consumer.v1.GetSupportedLanguagesResponse.languages:type_name -> content.consumer.v1.SupportedLanguage
+	18, // 4: content.consumer.v1.CreateCompileRequest.test_cases:type_name -> content.consumer.v1.TestCase
+	17, // 5: content.consumer.v1.CreateCompileRequest.files:type_name -> content.consumer.v1.SourceFile
+	16, // 6: content.consumer.v1.CreateCompileRequest.comparison:type_name -> content.consumer.v1.OutputComparison
+	15, // 7: content.consumer.v1.CreateCompileRequest.checker:type_name -> content.consumer.v1.Checker
+	14, // 8: content.consumer.v1.CreateCompileRequest.interactor:type_name -> content.consumer.v1.Interactor
+	13, // 9: content.consumer.v1.CreateCompileRequest.test_groups:type_name -> content.consumer.v1.TestGroup
+	0,  // 10: content.consumer.v1.TestGroup.policy:type_name -> content.consumer.v1.ScoringPolicy
+	1,  // 11: content.consumer.v1.OutputComparison.mode:type_name -> content.consumer.v1.ComparisonMode
+	12, // 12: content.consumer.v1.CompileAndWaitRequest.request:type_name -> content.consumer.v1.CreateCompileRequest
+	23, // 13: content.consumer.v1.CompileAndWaitResponse.result:type_name -> content.consumer.v1.GetCompileResultResponse
+	25, // 14: content.consumer.v1.GetCompileResultResponse.test_cases:type_name -> content.consumer.v1.TestCaseResult
+	24, // 15: content.consumer.v1.GetCompileResultResponse.test_groups:type_name -> content.consumer.v1.TestGroupResult
+	26, // 16: content.consumer.v1.TestCaseResult.diff:type_name -> content.consumer.v1.OutputDiff
+	23, // 17: content.consumer.v1.WatchCompileResultResponse.result:type_name -> content.consumer.v1.GetCompileResultResponse
+	48, // 18: content.consumer.v1.ListExecutionsRequest.created_after:type_name -> google.protobuf.Timestamp
+	48, // 19: content.consumer.v1.ListExecutionsRequest.created_before:type_name -> google.protobuf.Timestamp
+	48, // 20: content.consumer.v1.ExecutionSummary.created_at:type_name -> google.protobuf.Timestamp
+	32, // 21: content.consumer.v1.ListExecutionsResponse.executions:type_name -> content.consumer.v1.ExecutionSummary
+	12, // 22: content.consumer.v1.CreateCompileBatchRequest.requests:type_name -> content.consumer.v1.CreateCompileRequest
+	36, // 23: content.consumer.v1.CreateCompileBatchResponse.results:type_name -> content.consumer.v1.CreateCompileBatchResult
+	38, // 24: content.consumer.v1.GetBatchResultResponse.status_counts:type_name -> content.consumer.v1.StatusCount
+	38, // 25: content.consumer.v1.GetBatchResultResponse.test_status_counts:type_name -> content.consumer.v1.StatusCount
+	41, // 26: content.consumer.v1.UploadSourceRequest.metadata:type_name -> content.consumer.v1.UploadSourceMetadata
+	2,  // 27: content.consumer.v1.UploadSourceMetadata.format:type_name -> content.consumer.v1.SourceFormat
+	45, // 28: content.consumer.v1.ListArtifactsResponse.artifacts:type_name -> content.consumer.v1.Artifact
+	49, // 29: content.consumer.v1.ConsumerService.Ping:input_type -> google.protobuf.Empty
+	4,  // 30: content.consumer.v1.ConsumerService.GetTemplate:input_type -> content.consumer.v1.GetTemplateRequest
+	6,  // 31: content.consumer.v1.ConsumerService.ListTemplates:input_type -> content.consumer.v1.ListTemplatesRequest
+	49, // 32: content.consumer.v1.ConsumerService.GetSupportedLanguages:input_type -> google.protobuf.Empty
+	12, // 33: content.consumer.v1.ConsumerService.CreateCompile:input_type -> content.consumer.v1.CreateCompileRequest
+	20, // 34: content.consumer.v1.ConsumerService.CompileAndWait:input_type -> content.consumer.v1.CompileAndWaitRequest
+	22, // 35: content.consumer.v1.ConsumerService.GetCompileResult:input_type -> content.consumer.v1.GetCompileResultRequest
+	27, // 36: content.consumer.v1.ConsumerService.WatchCompileResult:input_type -> content.consumer.v1.WatchCompileResultRequest
+	29, // 37: content.consumer.v1.ConsumerService.CancelCompile:input_type -> content.consumer.v1.CancelCompileRequest
+	31, // 38: content.consumer.v1.ConsumerService.ListExecutions:input_type -> content.consumer.v1.ListExecutionsRequest
+	34, // 39: content.consumer.v1.ConsumerService.CreateCompileBatch:input_type -> content.consumer.v1.CreateCompileBatchRequest
+	37, // 40: content.consumer.v1.ConsumerService.GetBatchResult:input_type -> content.consumer.v1.GetBatchResultRequest
+	40, // 41: content.consumer.v1.ConsumerService.UploadSource:input_type -> content.consumer.v1.UploadSourceRequest
+	43, // 42: content.consumer.v1.ConsumerService.ListArtifacts:input_type -> content.consumer.v1.ListArtifactsRequest
+	46, // 43: content.consumer.v1.ConsumerService.DownloadArtifact:input_type -> content.consumer.v1.DownloadArtifactRequest
+	3,  // 44: content.consumer.v1.ConsumerService.Ping:output_type -> content.consumer.v1.PingResponse
+	5,  // 45: content.consumer.v1.ConsumerService.GetTemplate:output_type -> content.consumer.v1.GetTemplateResponse
+	7,  // 46: content.consumer.v1.ConsumerService.ListTemplates:output_type -> content.consumer.v1.ListTemplatesResponse
+	11, // 47: content.consumer.v1.ConsumerService.GetSupportedLanguages:output_type -> content.consumer.v1.GetSupportedLanguagesResponse
+	19, // 48: content.consumer.v1.ConsumerService.CreateCompile:output_type -> content.consumer.v1.CreateCompileResponse
+	21, // 49: content.consumer.v1.ConsumerService.CompileAndWait:output_type -> content.consumer.v1.CompileAndWaitResponse
+	23, // 50: content.consumer.v1.ConsumerService.GetCompileResult:output_type -> content.consumer.v1.GetCompileResultResponse
+	28, // 51: content.consumer.v1.ConsumerService.WatchCompileResult:output_type -> content.consumer.v1.WatchCompileResultResponse
+	30, // 52: content.consumer.v1.ConsumerService.CancelCompile:output_type -> content.consumer.v1.CancelCompileResponse
+	33, // 53: content.consumer.v1.ConsumerService.ListExecutions:output_type -> content.consumer.v1.ListExecutionsResponse
+	35, // 54: content.consumer.v1.ConsumerService.CreateCompileBatch:output_type -> content.consumer.v1.CreateCompileBatchResponse
+	39, // 55: content.consumer.v1.ConsumerService.GetBatchResult:output_type -> content.consumer.v1.GetBatchResultResponse
+	42, // 56: content.consumer.v1.ConsumerService.UploadSource:output_type -> content.consumer.v1.UploadSourceResponse
+	44, // 57: content.consumer.v1.ConsumerService.ListArtifacts:output_type -> content.consumer.v1.ListArtifactsResponse
+	47, // 58: content.consumer.v1.ConsumerService.DownloadArtifact:output_type -> content.consumer.v1.DownloadArtifactResponse
+	44, // [44:59] is the sub-list for method output_type
+	29, // [29:44] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_content_consumer_v1_consumer_proto_init() }
@@ -769,8 +4320,152 @@ func file_content_consumer_v1_consumer_proto_init() {
 				return nil
 			}
 		}
-		file_content_consumer_v1_consumer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTemplateRequest); i {
+		file_content_consumer_v1_consumer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateVariant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupportedLanguage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LanguageLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSupportedLanguagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCompileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interactor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Checker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutputComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -781,8 +4476,8 @@ func file_content_consumer_v1_consumer_proto_init() {
 				return nil
 			}
 		}
-		file_content_consumer_v1_consumer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTemplateResponse); i {
+		file_content_consumer_v1_consumer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -793,8 +4488,8 @@ func file_content_consumer_v1_consumer_proto_init() {
 				return nil
 			}
 		}
-		file_content_consumer_v1_consumer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SupportedLanguage); i {
+		file_content_consumer_v1_consumer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestCase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -805,8 +4500,8 @@ func file_content_consumer_v1_consumer_proto_init() {
 				return nil
 			}
 		}
-		file_content_consumer_v1_consumer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSupportedLanguagesResponse); i {
+		file_content_consumer_v1_consumer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCompileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -817,8 +4512,8 @@ func file_content_consumer_v1_consumer_proto_init() {
 				return nil
 			}
 		}
-		file_content_consumer_v1_consumer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCompileRequest); i {
+		file_content_consumer_v1_consumer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompileAndWaitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -829,8 +4524,8 @@ func file_content_consumer_v1_consumer_proto_init() {
 				return nil
 			}
 		}
-		file_content_consumer_v1_consumer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCompileResponse); i {
+		file_content_consumer_v1_consumer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompileAndWaitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -841,7 +4536,7 @@ func file_content_consumer_v1_consumer_proto_init() {
 				return nil
 			}
 		}
-		file_content_consumer_v1_consumer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_content_consumer_v1_consumer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCompileResultRequest); i {
 			case 0:
 				return &v.state
@@ -853,7 +4548,7 @@ func file_content_consumer_v1_consumer_proto_init() {
 				return nil
 			}
 		}
-		file_content_consumer_v1_consumer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_content_consumer_v1_consumer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCompileResultResponse); i {
 			case 0:
 				return &v.state
@@ -865,19 +4560,312 @@ func file_content_consumer_v1_consumer_proto_init() {
 				return nil
 			}
 		}
+		file_content_consumer_v1_consumer_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestGroupResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestCaseResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutputDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCompileResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCompileResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelCompileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelCompileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExecutionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExecutionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCompileBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCompileBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCompileBatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadSourceMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadSourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArtifactsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArtifactsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadArtifactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_content_consumer_v1_consumer_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadArtifactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_content_consumer_v1_consumer_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*UploadSourceRequest_Metadata)(nil),
+		(*UploadSourceRequest_Chunk)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_content_consumer_v1_consumer_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_content_consumer_v1_consumer_proto_goTypes,
 		DependencyIndexes: file_content_consumer_v1_consumer_proto_depIdxs,
+		EnumInfos:         file_content_consumer_v1_consumer_proto_enumTypes,
 		MessageInfos:      file_content_consumer_v1_consumer_proto_msgTypes,
 	}.Build()
 	File_content_consumer_v1_consumer_proto = out.File
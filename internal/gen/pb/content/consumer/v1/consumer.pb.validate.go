@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _consumer_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on PingResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -169,6 +172,32 @@ func (m *GetTemplateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetVariant() != "" {
+
+		if utf8.RuneCountInString(m.GetVariant()) > 64 {
+			err := GetTemplateRequestValidationError{
+				field:  "Variant",
+				reason: "value length must be at most 64 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_GetTemplateRequest_Variant_Pattern.MatchString(m.GetVariant()) {
+			err := GetTemplateRequestValidationError{
+				field:  "Variant",
+				reason: "value does not match regex pattern \"^[a-z0-9][a-z0-9-]*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return GetTemplateRequestMultiError(errors)
 	}
@@ -266,6 +295,8 @@ var _GetTemplateRequest_Language_InLookup = map[string]struct{}{
 	"php":     {},
 }
 
+var _GetTemplateRequest_Variant_Pattern = regexp.MustCompile("^[a-z0-9][a-z0-9-]*$")
+
 // Validate checks the field values on GetTemplateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -370,46 +401,57 @@ var _ interface {
 	ErrorName() string
 } = GetTemplateResponseValidationError{}
 
-// Validate checks the field values on SupportedLanguage with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SupportedLanguage) Validate() error {
+// Validate checks the field values on ListTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplatesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SupportedLanguage with the rules
+// ValidateAll checks the field values on ListTemplatesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SupportedLanguageMultiError, or nil if none found.
-func (m *SupportedLanguage) ValidateAll() error {
+// ListTemplatesRequestMultiError, or nil if none found.
+func (m *ListTemplatesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SupportedLanguage) validate(all bool) error {
+func (m *ListTemplatesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for LanguageCode
+	if m.GetLanguage() != "" {
 
-	// no validation rules for DisplayName
+		if _, ok := _ListTemplatesRequest_Language_InLookup[m.GetLanguage()]; !ok {
+			err := ListTemplatesRequestValidationError{
+				field:  "Language",
+				reason: "value must be in list [python2 python node rust ruby go c cpp fsharp csharp java kotlin scala php]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return SupportedLanguageMultiError(errors)
+		return ListTemplatesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SupportedLanguageMultiError is an error wrapping multiple validation errors
-// returned by SupportedLanguage.ValidateAll() if the designated constraints
-// aren't met.
-type SupportedLanguageMultiError []error
+// ListTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTemplatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTemplatesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SupportedLanguageMultiError) Error() string {
+func (m ListTemplatesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -418,11 +460,11 @@ func (m SupportedLanguageMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SupportedLanguageMultiError) AllErrors() []error { return m }
+func (m ListTemplatesRequestMultiError) AllErrors() []error { return m }
 
-// SupportedLanguageValidationError is the validation error returned by
-// SupportedLanguage.Validate if the designated constraints aren't met.
-type SupportedLanguageValidationError struct {
+// ListTemplatesRequestValidationError is the validation error returned by
+// ListTemplatesRequest.Validate if the designated constraints aren't met.
+type ListTemplatesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -430,24 +472,24 @@ type SupportedLanguageValidationError struct {
 }
 
 // Field function returns field value.
-func (e SupportedLanguageValidationError) Field() string { return e.field }
+func (e ListTemplatesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SupportedLanguageValidationError) Reason() string { return e.reason }
+func (e ListTemplatesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SupportedLanguageValidationError) Cause() error { return e.cause }
+func (e ListTemplatesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SupportedLanguageValidationError) Key() bool { return e.key }
+func (e ListTemplatesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SupportedLanguageValidationError) ErrorName() string {
-	return "SupportedLanguageValidationError"
+func (e ListTemplatesRequestValidationError) ErrorName() string {
+	return "ListTemplatesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SupportedLanguageValidationError) Error() string {
+func (e ListTemplatesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -459,14 +501,14 @@ func (e SupportedLanguageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSupportedLanguage.%s: %s%s",
+		"invalid %sListTemplatesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SupportedLanguageValidationError{}
+var _ error = ListTemplatesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -474,47 +516,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SupportedLanguageValidationError{}
+} = ListTemplatesRequestValidationError{}
 
-// Validate checks the field values on GetSupportedLanguagesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+var _ListTemplatesRequest_Language_InLookup = map[string]struct{}{
+	"python2": {},
+	"python":  {},
+	"node":    {},
+	"rust":    {},
+	"ruby":    {},
+	"go":      {},
+	"c":       {},
+	"cpp":     {},
+	"fsharp":  {},
+	"csharp":  {},
+	"java":    {},
+	"kotlin":  {},
+	"scala":   {},
+	"php":     {},
+}
+
+// Validate checks the field values on ListTemplatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSupportedLanguagesResponse) Validate() error {
+func (m *ListTemplatesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSupportedLanguagesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetSupportedLanguagesResponseMultiError, or nil if none found.
-func (m *GetSupportedLanguagesResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListTemplatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTemplatesResponseMultiError, or nil if none found.
+func (m *ListTemplatesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSupportedLanguagesResponse) validate(all bool) error {
+func (m *ListTemplatesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetLanguages() {
+	for idx, item := range m.GetTemplates() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetSupportedLanguagesResponseValidationError{
-						field:  fmt.Sprintf("Languages[%v]", idx),
+					errors = append(errors, ListTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetSupportedLanguagesResponseValidationError{
-						field:  fmt.Sprintf("Languages[%v]", idx),
+					errors = append(errors, ListTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -522,8 +581,8 @@ func (m *GetSupportedLanguagesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetSupportedLanguagesResponseValidationError{
-					field:  fmt.Sprintf("Languages[%v]", idx),
+				return ListTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -533,19 +592,19 @@ func (m *GetSupportedLanguagesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetSupportedLanguagesResponseMultiError(errors)
+		return ListTemplatesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSupportedLanguagesResponseMultiError is an error wrapping multiple
-// validation errors returned by GetSupportedLanguagesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GetSupportedLanguagesResponseMultiError []error
+// ListTemplatesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTemplatesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTemplatesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSupportedLanguagesResponseMultiError) Error() string {
+func (m ListTemplatesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -554,12 +613,11 @@ func (m GetSupportedLanguagesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSupportedLanguagesResponseMultiError) AllErrors() []error { return m }
+func (m ListTemplatesResponseMultiError) AllErrors() []error { return m }
 
-// GetSupportedLanguagesResponseValidationError is the validation error
-// returned by GetSupportedLanguagesResponse.Validate if the designated
-// constraints aren't met.
-type GetSupportedLanguagesResponseValidationError struct {
+// ListTemplatesResponseValidationError is the validation error returned by
+// ListTemplatesResponse.Validate if the designated constraints aren't met.
+type ListTemplatesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -567,24 +625,24 @@ type GetSupportedLanguagesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSupportedLanguagesResponseValidationError) Field() string { return e.field }
+func (e ListTemplatesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSupportedLanguagesResponseValidationError) Reason() string { return e.reason }
+func (e ListTemplatesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSupportedLanguagesResponseValidationError) Cause() error { return e.cause }
+func (e ListTemplatesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSupportedLanguagesResponseValidationError) Key() bool { return e.key }
+func (e ListTemplatesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSupportedLanguagesResponseValidationError) ErrorName() string {
-	return "GetSupportedLanguagesResponseValidationError"
+func (e ListTemplatesResponseValidationError) ErrorName() string {
+	return "ListTemplatesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSupportedLanguagesResponseValidationError) Error() string {
+func (e ListTemplatesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -596,14 +654,14 @@ func (e GetSupportedLanguagesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSupportedLanguagesResponse.%s: %s%s",
+		"invalid %sListTemplatesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSupportedLanguagesResponseValidationError{}
+var _ error = ListTemplatesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -611,66 +669,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSupportedLanguagesResponseValidationError{}
+} = ListTemplatesResponseValidationError{}
 
-// Validate checks the field values on CreateCompileRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateCompileRequest) Validate() error {
+// Validate checks the field values on TemplateVariant with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TemplateVariant) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateCompileRequest with the rules
+// ValidateAll checks the field values on TemplateVariant with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateCompileRequestMultiError, or nil if none found.
-func (m *CreateCompileRequest) ValidateAll() error {
+// TemplateVariantMultiError, or nil if none found.
+func (m *TemplateVariant) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateCompileRequest) validate(all bool) error {
+func (m *TemplateVariant) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if _, ok := _CreateCompileRequest_Language_InLookup[m.GetLanguage()]; !ok {
-		err := CreateCompileRequestValidationError{
-			field:  "Language",
-			reason: "value must be in list [python2 python node rust ruby go c cpp fsharp csharp java kotlin scala php]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Language
 
-	if l := utf8.RuneCountInString(m.GetSource()); l < 5 || l > 1024 {
-		err := CreateCompileRequestValidationError{
-			field:  "Source",
-			reason: "value length must be between 5 and 1024 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Variant
 
 	if len(errors) > 0 {
-		return CreateCompileRequestMultiError(errors)
+		return TemplateVariantMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateCompileRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateCompileRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateCompileRequestMultiError []error
+// TemplateVariantMultiError is an error wrapping multiple validation errors
+// returned by TemplateVariant.ValidateAll() if the designated constraints
+// aren't met.
+type TemplateVariantMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateCompileRequestMultiError) Error() string {
+func (m TemplateVariantMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -679,11 +719,11 @@ func (m CreateCompileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateCompileRequestMultiError) AllErrors() []error { return m }
+func (m TemplateVariantMultiError) AllErrors() []error { return m }
 
-// CreateCompileRequestValidationError is the validation error returned by
-// CreateCompileRequest.Validate if the designated constraints aren't met.
-type CreateCompileRequestValidationError struct {
+// TemplateVariantValidationError is the validation error returned by
+// TemplateVariant.Validate if the designated constraints aren't met.
+type TemplateVariantValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -691,24 +731,22 @@ type CreateCompileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateCompileRequestValidationError) Field() string { return e.field }
+func (e TemplateVariantValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateCompileRequestValidationError) Reason() string { return e.reason }
+func (e TemplateVariantValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateCompileRequestValidationError) Cause() error { return e.cause }
+func (e TemplateVariantValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateCompileRequestValidationError) Key() bool { return e.key }
+func (e TemplateVariantValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateCompileRequestValidationError) ErrorName() string {
-	return "CreateCompileRequestValidationError"
-}
+func (e TemplateVariantValidationError) ErrorName() string { return "TemplateVariantValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateCompileRequestValidationError) Error() string {
+func (e TemplateVariantValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -720,14 +758,14 @@ func (e CreateCompileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateCompileRequest.%s: %s%s",
+		"invalid %sTemplateVariant.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateCompileRequestValidationError{}
+var _ error = TemplateVariantValidationError{}
 
 var _ interface {
 	Field() string
@@ -735,63 +773,118 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateCompileRequestValidationError{}
-
-var _CreateCompileRequest_Language_InLookup = map[string]struct{}{
-	"python2": {},
-	"python":  {},
-	"node":    {},
-	"rust":    {},
-	"ruby":    {},
-	"go":      {},
-	"c":       {},
-	"cpp":     {},
-	"fsharp":  {},
-	"csharp":  {},
-	"java":    {},
-	"kotlin":  {},
-	"scala":   {},
-	"php":     {},
-}
+} = TemplateVariantValidationError{}
 
-// Validate checks the field values on CreateCompileResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateCompileResponse) Validate() error {
+// Validate checks the field values on SupportedLanguage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SupportedLanguage) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateCompileResponse with the rules
+// ValidateAll checks the field values on SupportedLanguage with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateCompileResponseMultiError, or nil if none found.
-func (m *CreateCompileResponse) ValidateAll() error {
+// SupportedLanguageMultiError, or nil if none found.
+func (m *SupportedLanguage) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateCompileResponse) validate(all bool) error {
+func (m *SupportedLanguage) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for LanguageCode
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Version
+
+	// no validation rules for RunCommand
+
+	// no validation rules for SourceFile
+
+	// no validation rules for Interpreted
+
+	if all {
+		switch v := interface{}(m.GetLimits()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SupportedLanguageValidationError{
+					field:  "Limits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SupportedLanguageValidationError{
+					field:  "Limits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLimits()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SupportedLanguageValidationError{
+				field:  "Limits",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TemplateAvailable
+
+	// no validation rules for Available
+
+	if all {
+		switch v := interface{}(m.GetCheckedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SupportedLanguageValidationError{
+					field:  "CheckedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SupportedLanguageValidationError{
+					field:  "CheckedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheckedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SupportedLanguageValidationError{
+				field:  "CheckedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CreateCompileResponseMultiError(errors)
+		return SupportedLanguageMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateCompileResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateCompileResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateCompileResponseMultiError []error
+// SupportedLanguageMultiError is an error wrapping multiple validation errors
+// returned by SupportedLanguage.ValidateAll() if the designated constraints
+// aren't met.
+type SupportedLanguageMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateCompileResponseMultiError) Error() string {
+func (m SupportedLanguageMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -800,11 +893,11 @@ func (m CreateCompileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateCompileResponseMultiError) AllErrors() []error { return m }
+func (m SupportedLanguageMultiError) AllErrors() []error { return m }
 
-// CreateCompileResponseValidationError is the validation error returned by
-// CreateCompileResponse.Validate if the designated constraints aren't met.
-type CreateCompileResponseValidationError struct {
+// SupportedLanguageValidationError is the validation error returned by
+// SupportedLanguage.Validate if the designated constraints aren't met.
+type SupportedLanguageValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -812,24 +905,4846 @@ type CreateCompileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateCompileResponseValidationError) Field() string { return e.field }
+func (e SupportedLanguageValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateCompileResponseValidationError) Reason() string { return e.reason }
+func (e SupportedLanguageValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateCompileResponseValidationError) Cause() error { return e.cause }
+func (e SupportedLanguageValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateCompileResponseValidationError) Key() bool { return e.key }
+func (e SupportedLanguageValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateCompileResponseValidationError) ErrorName() string {
-	return "CreateCompileResponseValidationError"
+func (e SupportedLanguageValidationError) ErrorName() string {
+	return "SupportedLanguageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SupportedLanguageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSupportedLanguage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SupportedLanguageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SupportedLanguageValidationError{}
+
+// Validate checks the field values on LanguageLimits with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LanguageLimits) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LanguageLimits with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LanguageLimitsMultiError,
+// or nil if none found.
+func (m *LanguageLimits) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LanguageLimits) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TimeLimitMs
+
+	// no validation rules for CompileTimeLimitMs
+
+	// no validation rules for MemoryLimitMb
+
+	// no validation rules for MaxTimeLimitMs
+
+	// no validation rules for MaxCompileTimeLimitMs
+
+	// no validation rules for MaxMemoryLimitMb
+
+	if len(errors) > 0 {
+		return LanguageLimitsMultiError(errors)
+	}
+
+	return nil
+}
+
+// LanguageLimitsMultiError is an error wrapping multiple validation errors
+// returned by LanguageLimits.ValidateAll() if the designated constraints
+// aren't met.
+type LanguageLimitsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LanguageLimitsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LanguageLimitsMultiError) AllErrors() []error { return m }
+
+// LanguageLimitsValidationError is the validation error returned by
+// LanguageLimits.Validate if the designated constraints aren't met.
+type LanguageLimitsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LanguageLimitsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LanguageLimitsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LanguageLimitsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LanguageLimitsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LanguageLimitsValidationError) ErrorName() string { return "LanguageLimitsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LanguageLimitsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLanguageLimits.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LanguageLimitsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LanguageLimitsValidationError{}
+
+// Validate checks the field values on GetSupportedLanguagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSupportedLanguagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSupportedLanguagesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSupportedLanguagesResponseMultiError, or nil if none found.
+func (m *GetSupportedLanguagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSupportedLanguagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLanguages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSupportedLanguagesResponseValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSupportedLanguagesResponseValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSupportedLanguagesResponseValidationError{
+					field:  fmt.Sprintf("Languages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSupportedLanguagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSupportedLanguagesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSupportedLanguagesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetSupportedLanguagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSupportedLanguagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSupportedLanguagesResponseMultiError) AllErrors() []error { return m }
+
+// GetSupportedLanguagesResponseValidationError is the validation error
+// returned by GetSupportedLanguagesResponse.Validate if the designated
+// constraints aren't met.
+type GetSupportedLanguagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSupportedLanguagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSupportedLanguagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSupportedLanguagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSupportedLanguagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSupportedLanguagesResponseValidationError) ErrorName() string {
+	return "GetSupportedLanguagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSupportedLanguagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSupportedLanguagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSupportedLanguagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSupportedLanguagesResponseValidationError{}
+
+// Validate checks the field values on CreateCompileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCompileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCompileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCompileRequestMultiError, or nil if none found.
+func (m *CreateCompileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCompileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _CreateCompileRequest_Language_InLookup[m.GetLanguage()]; !ok {
+		err := CreateCompileRequestValidationError{
+			field:  "Language",
+			reason: "value must be in list [python2 python node rust ruby go c cpp fsharp csharp java kotlin scala php]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSource() != "" {
+
+		if l := utf8.RuneCountInString(m.GetSource()); l < 5 || l > 1024 {
+			err := CreateCompileRequestValidationError{
+				field:  "Source",
+				reason: "value length must be between 5 and 1024 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetTestCases()) > 50 {
+		err := CreateCompileRequestValidationError{
+			field:  "TestCases",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTestCases() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateCompileRequestValidationError{
+						field:  fmt.Sprintf("TestCases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateCompileRequestValidationError{
+						field:  fmt.Sprintf("TestCases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateCompileRequestValidationError{
+					field:  fmt.Sprintf("TestCases[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TimeLimitMs
+
+	// no validation rules for CompileTimeLimitMs
+
+	// no validation rules for MemoryLimitMb
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		err := CreateCompileRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCallbackUrl() != "" {
+
+		if utf8.RuneCountInString(m.GetCallbackUrl()) > 2048 {
+			err := CreateCompileRequestValidationError{
+				field:  "CallbackUrl",
+				reason: "value length must be at most 2048 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if uri, err := url.Parse(m.GetCallbackUrl()); err != nil {
+			err = CreateCompileRequestValidationError{
+				field:  "CallbackUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := CreateCompileRequestValidationError{
+				field:  "CallbackUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetFiles()) > 100 {
+		err := CreateCompileRequestValidationError{
+			field:  "Files",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateCompileRequestValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateCompileRequestValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateCompileRequestValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetEntryFile()) > 255 {
+		err := CreateCompileRequestValidationError{
+			field:  "EntryFile",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSourceId() != "" {
+
+		if err := m._validateUuid(m.GetSourceId()); err != nil {
+			err = CreateCompileRequestValidationError{
+				field:  "SourceId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for IncludeBinary
+
+	if all {
+		switch v := interface{}(m.GetComparison()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCompileRequestValidationError{
+					field:  "Comparison",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCompileRequestValidationError{
+					field:  "Comparison",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComparison()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCompileRequestValidationError{
+				field:  "Comparison",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetChecker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCompileRequestValidationError{
+					field:  "Checker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCompileRequestValidationError{
+					field:  "Checker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChecker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCompileRequestValidationError{
+				field:  "Checker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInteractor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCompileRequestValidationError{
+					field:  "Interactor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCompileRequestValidationError{
+					field:  "Interactor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInteractor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCompileRequestValidationError{
+				field:  "Interactor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncludeDiff
+
+	if len(m.GetTestGroups()) > 50 {
+		err := CreateCompileRequestValidationError{
+			field:  "TestGroups",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTestGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateCompileRequestValidationError{
+						field:  fmt.Sprintf("TestGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateCompileRequestValidationError{
+						field:  fmt.Sprintf("TestGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateCompileRequestValidationError{
+					field:  fmt.Sprintf("TestGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateCompileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateCompileRequest) _validateUuid(uuid string) error {
+	if matched := _consumer_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateCompileRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCompileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCompileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCompileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCompileRequestMultiError) AllErrors() []error { return m }
+
+// CreateCompileRequestValidationError is the validation error returned by
+// CreateCompileRequest.Validate if the designated constraints aren't met.
+type CreateCompileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCompileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCompileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCompileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCompileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCompileRequestValidationError) ErrorName() string {
+	return "CreateCompileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCompileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCompileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCompileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCompileRequestValidationError{}
+
+var _CreateCompileRequest_Language_InLookup = map[string]struct{}{
+	"python2": {},
+	"python":  {},
+	"node":    {},
+	"rust":    {},
+	"ruby":    {},
+	"go":      {},
+	"c":       {},
+	"cpp":     {},
+	"fsharp":  {},
+	"csharp":  {},
+	"java":    {},
+	"kotlin":  {},
+	"scala":   {},
+	"php":     {},
+}
+
+// Validate checks the field values on TestGroup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestGroupMultiError, or nil
+// if none found.
+func (m *TestGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := TestGroupValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TestGroup_Name_Pattern.MatchString(m.GetName()) {
+		err := TestGroupValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9._-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWeight() < 0 {
+		err := TestGroupValidationError{
+			field:  "Weight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ScoringPolicy_name[int32(m.GetPolicy())]; !ok {
+		err := TestGroupValidationError{
+			field:  "Policy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetDependencies()) > 50 {
+		err := TestGroupValidationError{
+			field:  "Dependencies",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetDependencies() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := TestGroupValidationError{
+				field:  fmt.Sprintf("Dependencies[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_TestGroup_Dependencies_Pattern.MatchString(item) {
+			err := TestGroupValidationError{
+				field:  fmt.Sprintf("Dependencies[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z0-9._-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TestGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestGroupMultiError is an error wrapping multiple validation errors returned
+// by TestGroup.ValidateAll() if the designated constraints aren't met.
+type TestGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestGroupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestGroupMultiError) AllErrors() []error { return m }
+
+// TestGroupValidationError is the validation error returned by
+// TestGroup.Validate if the designated constraints aren't met.
+type TestGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestGroupValidationError) ErrorName() string { return "TestGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestGroupValidationError{}
+
+var _TestGroup_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9._-]+$")
+
+var _TestGroup_Dependencies_Pattern = regexp.MustCompile("^[A-Za-z0-9._-]+$")
+
+// Validate checks the field values on Interactor with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Interactor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Interactor with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InteractorMultiError, or
+// nil if none found.
+func (m *Interactor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Interactor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Interactor_Language_InLookup[m.GetLanguage()]; !ok {
+		err := InteractorValidationError{
+			field:  "Language",
+			reason: "value must be in list [python2 python node rust ruby go c cpp php]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSource()); l < 5 || l > 65536 {
+		err := InteractorValidationError{
+			field:  "Source",
+			reason: "value length must be between 5 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InteractorMultiError(errors)
+	}
+
+	return nil
+}
+
+// InteractorMultiError is an error wrapping multiple validation errors
+// returned by Interactor.ValidateAll() if the designated constraints aren't met.
+type InteractorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InteractorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InteractorMultiError) AllErrors() []error { return m }
+
+// InteractorValidationError is the validation error returned by
+// Interactor.Validate if the designated constraints aren't met.
+type InteractorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InteractorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InteractorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InteractorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InteractorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InteractorValidationError) ErrorName() string { return "InteractorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InteractorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInteractor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InteractorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InteractorValidationError{}
+
+var _Interactor_Language_InLookup = map[string]struct{}{
+	"python2": {},
+	"python":  {},
+	"node":    {},
+	"rust":    {},
+	"ruby":    {},
+	"go":      {},
+	"c":       {},
+	"cpp":     {},
+	"php":     {},
+}
+
+// Validate checks the field values on Checker with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Checker) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Checker with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CheckerMultiError, or nil if none found.
+func (m *Checker) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Checker) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Checker_Language_InLookup[m.GetLanguage()]; !ok {
+		err := CheckerValidationError{
+			field:  "Language",
+			reason: "value must be in list [python2 python node rust ruby go c cpp fsharp csharp java kotlin scala php]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSource()); l < 5 || l > 65536 {
+		err := CheckerValidationError{
+			field:  "Source",
+			reason: "value length must be between 5 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckerMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckerMultiError is an error wrapping multiple validation errors returned
+// by Checker.ValidateAll() if the designated constraints aren't met.
+type CheckerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckerMultiError) AllErrors() []error { return m }
+
+// CheckerValidationError is the validation error returned by Checker.Validate
+// if the designated constraints aren't met.
+type CheckerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckerValidationError) ErrorName() string { return "CheckerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChecker.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckerValidationError{}
+
+var _Checker_Language_InLookup = map[string]struct{}{
+	"python2": {},
+	"python":  {},
+	"node":    {},
+	"rust":    {},
+	"ruby":    {},
+	"go":      {},
+	"c":       {},
+	"cpp":     {},
+	"fsharp":  {},
+	"csharp":  {},
+	"java":    {},
+	"kotlin":  {},
+	"scala":   {},
+	"php":     {},
+}
+
+// Validate checks the field values on OutputComparison with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OutputComparison) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutputComparison with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OutputComparisonMultiError, or nil if none found.
+func (m *OutputComparison) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutputComparison) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ComparisonMode_name[int32(m.GetMode())]; !ok {
+		err := OutputComparisonValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAbsoluteEpsilon() < 0 {
+		err := OutputComparisonValidationError{
+			field:  "AbsoluteEpsilon",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRelativeEpsilon() < 0 {
+		err := OutputComparisonValidationError{
+			field:  "RelativeEpsilon",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OutputComparisonMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutputComparisonMultiError is an error wrapping multiple validation errors
+// returned by OutputComparison.ValidateAll() if the designated constraints
+// aren't met.
+type OutputComparisonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutputComparisonMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutputComparisonMultiError) AllErrors() []error { return m }
+
+// OutputComparisonValidationError is the validation error returned by
+// OutputComparison.Validate if the designated constraints aren't met.
+type OutputComparisonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutputComparisonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutputComparisonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutputComparisonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutputComparisonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutputComparisonValidationError) ErrorName() string { return "OutputComparisonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutputComparisonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutputComparison.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutputComparisonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutputComparisonValidationError{}
+
+// Validate checks the field values on SourceFile with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SourceFile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SourceFile with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SourceFileMultiError, or
+// nil if none found.
+func (m *SourceFile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SourceFile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPath()); l < 1 || l > 255 {
+		err := SourceFileValidationError{
+			field:  "Path",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContent()) > 65536 {
+		err := SourceFileValidationError{
+			field:  "Content",
+			reason: "value length must be at most 65536 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SourceFileMultiError(errors)
+	}
+
+	return nil
+}
+
+// SourceFileMultiError is an error wrapping multiple validation errors
+// returned by SourceFile.ValidateAll() if the designated constraints aren't met.
+type SourceFileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SourceFileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SourceFileMultiError) AllErrors() []error { return m }
+
+// SourceFileValidationError is the validation error returned by
+// SourceFile.Validate if the designated constraints aren't met.
+type SourceFileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SourceFileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SourceFileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SourceFileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SourceFileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SourceFileValidationError) ErrorName() string { return "SourceFileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SourceFileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSourceFile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SourceFileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SourceFileValidationError{}
+
+// Validate checks the field values on TestCase with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestCase) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestCase with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestCaseMultiError, or nil
+// if none found.
+func (m *TestCase) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestCase) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetGroup()) > 64 {
+		err := TestCaseValidationError{
+			field:  "Group",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TestCase_Group_Pattern.MatchString(m.GetGroup()) {
+		err := TestCaseValidationError{
+			field:  "Group",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9._-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TestCaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestCaseMultiError is an error wrapping multiple validation errors returned
+// by TestCase.ValidateAll() if the designated constraints aren't met.
+type TestCaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestCaseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestCaseMultiError) AllErrors() []error { return m }
+
+// TestCaseValidationError is the validation error returned by
+// TestCase.Validate if the designated constraints aren't met.
+type TestCaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestCaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestCaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestCaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestCaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestCaseValidationError) ErrorName() string { return "TestCaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestCaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestCase.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestCaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestCaseValidationError{}
+
+var _TestCase_Group_Pattern = regexp.MustCompile("^[A-Za-z0-9._-]*$")
+
+// Validate checks the field values on CreateCompileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCompileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCompileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCompileResponseMultiError, or nil if none found.
+func (m *CreateCompileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCompileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreateCompileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCompileResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateCompileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCompileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCompileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCompileResponseMultiError) AllErrors() []error { return m }
+
+// CreateCompileResponseValidationError is the validation error returned by
+// CreateCompileResponse.Validate if the designated constraints aren't met.
+type CreateCompileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCompileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCompileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCompileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCompileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCompileResponseValidationError) ErrorName() string {
+	return "CreateCompileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCompileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCompileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCompileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCompileResponseValidationError{}
+
+// Validate checks the field values on CompileAndWaitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompileAndWaitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompileAndWaitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompileAndWaitRequestMultiError, or nil if none found.
+func (m *CompileAndWaitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompileAndWaitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetRequest() == nil {
+		err := CompileAndWaitRequestValidationError{
+			field:  "Request",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompileAndWaitRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompileAndWaitRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompileAndWaitRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CompileAndWaitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompileAndWaitRequestMultiError is an error wrapping multiple validation
+// errors returned by CompileAndWaitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CompileAndWaitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompileAndWaitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompileAndWaitRequestMultiError) AllErrors() []error { return m }
+
+// CompileAndWaitRequestValidationError is the validation error returned by
+// CompileAndWaitRequest.Validate if the designated constraints aren't met.
+type CompileAndWaitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompileAndWaitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompileAndWaitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompileAndWaitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompileAndWaitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompileAndWaitRequestValidationError) ErrorName() string {
+	return "CompileAndWaitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompileAndWaitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompileAndWaitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompileAndWaitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompileAndWaitRequestValidationError{}
+
+// Validate checks the field values on CompileAndWaitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompileAndWaitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompileAndWaitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompileAndWaitResponseMultiError, or nil if none found.
+func (m *CompileAndWaitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompileAndWaitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for Completed
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompileAndWaitResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompileAndWaitResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompileAndWaitResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CompileAndWaitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompileAndWaitResponseMultiError is an error wrapping multiple validation
+// errors returned by CompileAndWaitResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CompileAndWaitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompileAndWaitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompileAndWaitResponseMultiError) AllErrors() []error { return m }
+
+// CompileAndWaitResponseValidationError is the validation error returned by
+// CompileAndWaitResponse.Validate if the designated constraints aren't met.
+type CompileAndWaitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompileAndWaitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompileAndWaitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompileAndWaitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompileAndWaitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompileAndWaitResponseValidationError) ErrorName() string {
+	return "CompileAndWaitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompileAndWaitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompileAndWaitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompileAndWaitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompileAndWaitResponseValidationError{}
+
+// Validate checks the field values on GetCompileResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCompileResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCompileResultRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCompileResultRequestMultiError, or nil if none found.
+func (m *GetCompileResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCompileResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetCompileResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCompileResultRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCompileResultRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCompileResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCompileResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCompileResultRequestMultiError) AllErrors() []error { return m }
+
+// GetCompileResultRequestValidationError is the validation error returned by
+// GetCompileResultRequest.Validate if the designated constraints aren't met.
+type GetCompileResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCompileResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCompileResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCompileResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCompileResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCompileResultRequestValidationError) ErrorName() string {
+	return "GetCompileResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCompileResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCompileResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCompileResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCompileResultRequestValidationError{}
+
+// Validate checks the field values on GetCompileResultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCompileResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCompileResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCompileResultResponseMultiError, or nil if none found.
+func (m *GetCompileResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCompileResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Language
+
+	// no validation rules for Status
+
+	// no validation rules for TestStatus
+
+	// no validation rules for CompileMs
+
+	// no validation rules for RuntimeMs
+
+	// no validation rules for RuntimeMemoryMb
+
+	// no validation rules for Output
+
+	// no validation rules for OutputError
+
+	// no validation rules for CompilerOutput
+
+	for idx, item := range m.GetTestCases() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCompileResultResponseValidationError{
+						field:  fmt.Sprintf("TestCases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCompileResultResponseValidationError{
+						field:  fmt.Sprintf("TestCases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCompileResultResponseValidationError{
+					field:  fmt.Sprintf("TestCases[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Score
+
+	// no validation rules for MaxScore
+
+	for idx, item := range m.GetTestGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCompileResultResponseValidationError{
+						field:  fmt.Sprintf("TestGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCompileResultResponseValidationError{
+						field:  fmt.Sprintf("TestGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCompileResultResponseValidationError{
+					field:  fmt.Sprintf("TestGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCompileResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCompileResultResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCompileResultResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCompileResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCompileResultResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCompileResultResponseMultiError) AllErrors() []error { return m }
+
+// GetCompileResultResponseValidationError is the validation error returned by
+// GetCompileResultResponse.Validate if the designated constraints aren't met.
+type GetCompileResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCompileResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCompileResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCompileResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCompileResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCompileResultResponseValidationError) ErrorName() string {
+	return "GetCompileResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCompileResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCompileResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCompileResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCompileResultResponseValidationError{}
+
+// Validate checks the field values on TestGroupResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TestGroupResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestGroupResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TestGroupResultMultiError, or nil if none found.
+func (m *TestGroupResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestGroupResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for TestStatus
+
+	// no validation rules for Score
+
+	// no validation rules for Weight
+
+	if len(errors) > 0 {
+		return TestGroupResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestGroupResultMultiError is an error wrapping multiple validation errors
+// returned by TestGroupResult.ValidateAll() if the designated constraints
+// aren't met.
+type TestGroupResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestGroupResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestGroupResultMultiError) AllErrors() []error { return m }
+
+// TestGroupResultValidationError is the validation error returned by
+// TestGroupResult.Validate if the designated constraints aren't met.
+type TestGroupResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestGroupResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestGroupResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestGroupResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestGroupResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestGroupResultValidationError) ErrorName() string { return "TestGroupResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestGroupResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestGroupResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestGroupResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestGroupResultValidationError{}
+
+// Validate checks the field values on TestCaseResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestCaseResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestCaseResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestCaseResultMultiError,
+// or nil if none found.
+func (m *TestCaseResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestCaseResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for TestStatus
+
+	// no validation rules for RuntimeMs
+
+	// no validation rules for RuntimeMemoryMb
+
+	// no validation rules for Score
+
+	// no validation rules for CheckerMessage
+
+	// no validation rules for InteractorRuntimeMs
+
+	// no validation rules for InteractorRuntimeMemoryMb
+
+	if all {
+		switch v := interface{}(m.GetDiff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TestCaseResultValidationError{
+					field:  "Diff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TestCaseResultValidationError{
+					field:  "Diff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TestCaseResultValidationError{
+				field:  "Diff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TestCaseResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestCaseResultMultiError is an error wrapping multiple validation errors
+// returned by TestCaseResult.ValidateAll() if the designated constraints
+// aren't met.
+type TestCaseResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestCaseResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestCaseResultMultiError) AllErrors() []error { return m }
+
+// TestCaseResultValidationError is the validation error returned by
+// TestCaseResult.Validate if the designated constraints aren't met.
+type TestCaseResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestCaseResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestCaseResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestCaseResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestCaseResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestCaseResultValidationError) ErrorName() string { return "TestCaseResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestCaseResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestCaseResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestCaseResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestCaseResultValidationError{}
+
+// Validate checks the field values on OutputDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutputDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutputDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutputDiffMultiError, or
+// nil if none found.
+func (m *OutputDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutputDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Line
+
+	// no validation rules for Column
+
+	// no validation rules for Expected
+
+	// no validation rules for Actual
+
+	// no validation rules for ExpectedLines
+
+	// no validation rules for ActualLines
+
+	// no validation rules for UnifiedDiff
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return OutputDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutputDiffMultiError is an error wrapping multiple validation errors
+// returned by OutputDiff.ValidateAll() if the designated constraints aren't met.
+type OutputDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutputDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutputDiffMultiError) AllErrors() []error { return m }
+
+// OutputDiffValidationError is the validation error returned by
+// OutputDiff.Validate if the designated constraints aren't met.
+type OutputDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutputDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutputDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutputDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutputDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutputDiffValidationError) ErrorName() string { return "OutputDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutputDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutputDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutputDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutputDiffValidationError{}
+
+// Validate checks the field values on WatchCompileResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchCompileResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchCompileResultRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchCompileResultRequestMultiError, or nil if none found.
+func (m *WatchCompileResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchCompileResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return WatchCompileResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchCompileResultRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchCompileResultRequest.ValidateAll() if the
+// designated constraints aren't met.
+type WatchCompileResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchCompileResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchCompileResultRequestMultiError) AllErrors() []error { return m }
+
+// WatchCompileResultRequestValidationError is the validation error returned by
+// WatchCompileResultRequest.Validate if the designated constraints aren't met.
+type WatchCompileResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchCompileResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchCompileResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchCompileResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchCompileResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchCompileResultRequestValidationError) ErrorName() string {
+	return "WatchCompileResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchCompileResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchCompileResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchCompileResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchCompileResultRequestValidationError{}
+
+// Validate checks the field values on WatchCompileResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchCompileResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchCompileResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchCompileResultResponseMultiError, or nil if none found.
+func (m *WatchCompileResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchCompileResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for TestStatus
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchCompileResultResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchCompileResultResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchCompileResultResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchCompileResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchCompileResultResponseMultiError is an error wrapping multiple
+// validation errors returned by WatchCompileResultResponse.ValidateAll() if
+// the designated constraints aren't met.
+type WatchCompileResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchCompileResultResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchCompileResultResponseMultiError) AllErrors() []error { return m }
+
+// WatchCompileResultResponseValidationError is the validation error returned
+// by WatchCompileResultResponse.Validate if the designated constraints aren't met.
+type WatchCompileResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchCompileResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchCompileResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchCompileResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchCompileResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchCompileResultResponseValidationError) ErrorName() string {
+	return "WatchCompileResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchCompileResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchCompileResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchCompileResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchCompileResultResponseValidationError{}
+
+// Validate checks the field values on CancelCompileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelCompileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelCompileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelCompileRequestMultiError, or nil if none found.
+func (m *CancelCompileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelCompileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CancelCompileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelCompileRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelCompileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelCompileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelCompileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelCompileRequestMultiError) AllErrors() []error { return m }
+
+// CancelCompileRequestValidationError is the validation error returned by
+// CancelCompileRequest.Validate if the designated constraints aren't met.
+type CancelCompileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelCompileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelCompileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelCompileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelCompileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelCompileRequestValidationError) ErrorName() string {
+	return "CancelCompileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelCompileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelCompileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelCompileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelCompileRequestValidationError{}
+
+// Validate checks the field values on CancelCompileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelCompileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelCompileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelCompileResponseMultiError, or nil if none found.
+func (m *CancelCompileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelCompileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return CancelCompileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelCompileResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelCompileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelCompileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelCompileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelCompileResponseMultiError) AllErrors() []error { return m }
+
+// CancelCompileResponseValidationError is the validation error returned by
+// CancelCompileResponse.Validate if the designated constraints aren't met.
+type CancelCompileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelCompileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelCompileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelCompileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelCompileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelCompileResponseValidationError) ErrorName() string {
+	return "CancelCompileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelCompileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelCompileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelCompileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelCompileResponseValidationError{}
+
+// Validate checks the field values on ListExecutionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListExecutionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListExecutionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListExecutionsRequestMultiError, or nil if none found.
+func (m *ListExecutionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListExecutionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLanguage() != "" {
+
+		if _, ok := _ListExecutionsRequest_Language_InLookup[m.GetLanguage()]; !ok {
+			err := ListExecutionsRequestValidationError{
+				field:  "Language",
+				reason: "value must be in list [python2 python node rust ruby go c cpp fsharp csharp java kotlin scala php]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for TestStatus
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListExecutionsRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListExecutionsRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListExecutionsRequestValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListExecutionsRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListExecutionsRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListExecutionsRequestValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListExecutionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListExecutionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListExecutionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListExecutionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListExecutionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListExecutionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListExecutionsRequestMultiError) AllErrors() []error { return m }
+
+// ListExecutionsRequestValidationError is the validation error returned by
+// ListExecutionsRequest.Validate if the designated constraints aren't met.
+type ListExecutionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExecutionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExecutionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExecutionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExecutionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExecutionsRequestValidationError) ErrorName() string {
+	return "ListExecutionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExecutionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExecutionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExecutionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExecutionsRequestValidationError{}
+
+var _ListExecutionsRequest_Language_InLookup = map[string]struct{}{
+	"python2": {},
+	"python":  {},
+	"node":    {},
+	"rust":    {},
+	"ruby":    {},
+	"go":      {},
+	"c":       {},
+	"cpp":     {},
+	"fsharp":  {},
+	"csharp":  {},
+	"java":    {},
+	"kotlin":  {},
+	"scala":   {},
+	"php":     {},
+}
+
+// Validate checks the field values on ExecutionSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExecutionSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecutionSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExecutionSummaryMultiError, or nil if none found.
+func (m *ExecutionSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecutionSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Language
+
+	// no validation rules for Status
+
+	// no validation rules for TestStatus
+
+	// no validation rules for CompileMs
+
+	// no validation rules for RuntimeMs
+
+	// no validation rules for RuntimeMemoryMb
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExecutionSummaryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExecutionSummaryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExecutionSummaryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExecutionSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecutionSummaryMultiError is an error wrapping multiple validation errors
+// returned by ExecutionSummary.ValidateAll() if the designated constraints
+// aren't met.
+type ExecutionSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecutionSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecutionSummaryMultiError) AllErrors() []error { return m }
+
+// ExecutionSummaryValidationError is the validation error returned by
+// ExecutionSummary.Validate if the designated constraints aren't met.
+type ExecutionSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecutionSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecutionSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecutionSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecutionSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecutionSummaryValidationError) ErrorName() string { return "ExecutionSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExecutionSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecutionSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecutionSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecutionSummaryValidationError{}
+
+// Validate checks the field values on ListExecutionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListExecutionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListExecutionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListExecutionsResponseMultiError, or nil if none found.
+func (m *ListExecutionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListExecutionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetExecutions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListExecutionsResponseValidationError{
+						field:  fmt.Sprintf("Executions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListExecutionsResponseValidationError{
+						field:  fmt.Sprintf("Executions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListExecutionsResponseValidationError{
+					field:  fmt.Sprintf("Executions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListExecutionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListExecutionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListExecutionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListExecutionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListExecutionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListExecutionsResponseMultiError) AllErrors() []error { return m }
+
+// ListExecutionsResponseValidationError is the validation error returned by
+// ListExecutionsResponse.Validate if the designated constraints aren't met.
+type ListExecutionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExecutionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExecutionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExecutionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExecutionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExecutionsResponseValidationError) ErrorName() string {
+	return "ListExecutionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExecutionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExecutionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExecutionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExecutionsResponseValidationError{}
+
+// Validate checks the field values on CreateCompileBatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCompileBatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCompileBatchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCompileBatchRequestMultiError, or nil if none found.
+func (m *CreateCompileBatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCompileBatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetRequests()); l < 1 || l > 500 {
+		err := CreateCompileBatchRequestValidationError{
+			field:  "Requests",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateCompileBatchRequestValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateCompileBatchRequestValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateCompileBatchRequestValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateCompileBatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCompileBatchRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCompileBatchRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateCompileBatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCompileBatchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCompileBatchRequestMultiError) AllErrors() []error { return m }
+
+// CreateCompileBatchRequestValidationError is the validation error returned by
+// CreateCompileBatchRequest.Validate if the designated constraints aren't met.
+type CreateCompileBatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCompileBatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCompileBatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCompileBatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCompileBatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCompileBatchRequestValidationError) ErrorName() string {
+	return "CreateCompileBatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCompileBatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCompileBatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCompileBatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCompileBatchRequestValidationError{}
+
+// Validate checks the field values on CreateCompileBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCompileBatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCompileBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCompileBatchResponseMultiError, or nil if none found.
+func (m *CreateCompileBatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCompileBatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BatchId
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateCompileBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateCompileBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateCompileBatchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateCompileBatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCompileBatchResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateCompileBatchResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateCompileBatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCompileBatchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCompileBatchResponseMultiError) AllErrors() []error { return m }
+
+// CreateCompileBatchResponseValidationError is the validation error returned
+// by CreateCompileBatchResponse.Validate if the designated constraints aren't met.
+type CreateCompileBatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCompileBatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCompileBatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCompileBatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCompileBatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCompileBatchResponseValidationError) ErrorName() string {
+	return "CreateCompileBatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCompileBatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCompileBatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCompileBatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCompileBatchResponseValidationError{}
+
+// Validate checks the field values on CreateCompileBatchResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCompileBatchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCompileBatchResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCompileBatchResultMultiError, or nil if none found.
+func (m *CreateCompileBatchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCompileBatchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Queued
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return CreateCompileBatchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCompileBatchResultMultiError is an error wrapping multiple validation
+// errors returned by CreateCompileBatchResult.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCompileBatchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCompileBatchResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCompileBatchResultMultiError) AllErrors() []error { return m }
+
+// CreateCompileBatchResultValidationError is the validation error returned by
+// CreateCompileBatchResult.Validate if the designated constraints aren't met.
+type CreateCompileBatchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCompileBatchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCompileBatchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCompileBatchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCompileBatchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCompileBatchResultValidationError) ErrorName() string {
+	return "CreateCompileBatchResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCompileBatchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCompileBatchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCompileBatchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCompileBatchResultValidationError{}
+
+// Validate checks the field values on GetBatchResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBatchResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBatchResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBatchResultRequestMultiError, or nil if none found.
+func (m *GetBatchResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBatchResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetBatchResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBatchResultRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBatchResultRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBatchResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBatchResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBatchResultRequestMultiError) AllErrors() []error { return m }
+
+// GetBatchResultRequestValidationError is the validation error returned by
+// GetBatchResultRequest.Validate if the designated constraints aren't met.
+type GetBatchResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBatchResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBatchResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBatchResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBatchResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBatchResultRequestValidationError) ErrorName() string {
+	return "GetBatchResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBatchResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBatchResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBatchResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBatchResultRequestValidationError{}
+
+// Validate checks the field values on StatusCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatusCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatusCountMultiError, or
+// nil if none found.
+func (m *StatusCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return StatusCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusCountMultiError is an error wrapping multiple validation errors
+// returned by StatusCount.ValidateAll() if the designated constraints aren't met.
+type StatusCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusCountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusCountMultiError) AllErrors() []error { return m }
+
+// StatusCountValidationError is the validation error returned by
+// StatusCount.Validate if the designated constraints aren't met.
+type StatusCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusCountValidationError) ErrorName() string { return "StatusCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusCountValidationError{}
+
+// Validate checks the field values on GetBatchResultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBatchResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBatchResultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBatchResultResponseMultiError, or nil if none found.
+func (m *GetBatchResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBatchResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Completed
+
+	// no validation rules for CompletionPercentage
+
+	for idx, item := range m.GetStatusCounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetBatchResultResponseValidationError{
+						field:  fmt.Sprintf("StatusCounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetBatchResultResponseValidationError{
+						field:  fmt.Sprintf("StatusCounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetBatchResultResponseValidationError{
+					field:  fmt.Sprintf("StatusCounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTestStatusCounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetBatchResultResponseValidationError{
+						field:  fmt.Sprintf("TestStatusCounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetBatchResultResponseValidationError{
+						field:  fmt.Sprintf("TestStatusCounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetBatchResultResponseValidationError{
+					field:  fmt.Sprintf("TestStatusCounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetBatchResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBatchResultResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBatchResultResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBatchResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBatchResultResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBatchResultResponseMultiError) AllErrors() []error { return m }
+
+// GetBatchResultResponseValidationError is the validation error returned by
+// GetBatchResultResponse.Validate if the designated constraints aren't met.
+type GetBatchResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBatchResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBatchResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBatchResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBatchResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBatchResultResponseValidationError) ErrorName() string {
+	return "GetBatchResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBatchResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBatchResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBatchResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBatchResultResponseValidationError{}
+
+// Validate checks the field values on UploadSourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadSourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadSourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadSourceRequestMultiError, or nil if none found.
+func (m *UploadSourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadSourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofDataPresent := false
+	switch v := m.Data.(type) {
+	case *UploadSourceRequest_Metadata:
+		if v == nil {
+			err := UploadSourceRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofDataPresent = true
+
+		if all {
+			switch v := interface{}(m.GetMetadata()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadSourceRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadSourceRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadSourceRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadSourceRequest_Chunk:
+		if v == nil {
+			err := UploadSourceRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofDataPresent = true
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofDataPresent {
+		err := UploadSourceRequestValidationError{
+			field:  "Data",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadSourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadSourceRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadSourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadSourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadSourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadSourceRequestMultiError) AllErrors() []error { return m }
+
+// UploadSourceRequestValidationError is the validation error returned by
+// UploadSourceRequest.Validate if the designated constraints aren't met.
+type UploadSourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadSourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadSourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadSourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadSourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadSourceRequestValidationError) ErrorName() string {
+	return "UploadSourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadSourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadSourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadSourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadSourceRequestValidationError{}
+
+// Validate checks the field values on UploadSourceMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadSourceMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadSourceMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadSourceMetadataMultiError, or nil if none found.
+func (m *UploadSourceMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadSourceMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _UploadSourceMetadata_Format_NotInLookup[m.GetFormat()]; ok {
+		err := UploadSourceMetadataValidationError{
+			field:  "Format",
+			reason: "value must not be in list [SOURCE_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := SourceFormat_name[int32(m.GetFormat())]; !ok {
+		err := UploadSourceMetadataValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPath()) > 255 {
+		err := UploadSourceMetadataValidationError{
+			field:  "Path",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadSourceMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadSourceMetadataMultiError is an error wrapping multiple validation
+// errors returned by UploadSourceMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type UploadSourceMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadSourceMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadSourceMetadataMultiError) AllErrors() []error { return m }
+
+// UploadSourceMetadataValidationError is the validation error returned by
+// UploadSourceMetadata.Validate if the designated constraints aren't met.
+type UploadSourceMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadSourceMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadSourceMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadSourceMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadSourceMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadSourceMetadataValidationError) ErrorName() string {
+	return "UploadSourceMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadSourceMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadSourceMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadSourceMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadSourceMetadataValidationError{}
+
+var _UploadSourceMetadata_Format_NotInLookup = map[SourceFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on UploadSourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadSourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadSourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadSourceResponseMultiError, or nil if none found.
+func (m *UploadSourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadSourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SourceId
+
+	// no validation rules for SizeBytes
+
+	if len(errors) > 0 {
+		return UploadSourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadSourceResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadSourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadSourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadSourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadSourceResponseMultiError) AllErrors() []error { return m }
+
+// UploadSourceResponseValidationError is the validation error returned by
+// UploadSourceResponse.Validate if the designated constraints aren't met.
+type UploadSourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadSourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadSourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadSourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadSourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadSourceResponseValidationError) ErrorName() string {
+	return "UploadSourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadSourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadSourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadSourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadSourceResponseValidationError{}
+
+// Validate checks the field values on ListArtifactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListArtifactsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListArtifactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListArtifactsRequestMultiError, or nil if none found.
+func (m *ListArtifactsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListArtifactsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ListArtifactsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListArtifactsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListArtifactsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListArtifactsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListArtifactsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListArtifactsRequestMultiError) AllErrors() []error { return m }
+
+// ListArtifactsRequestValidationError is the validation error returned by
+// ListArtifactsRequest.Validate if the designated constraints aren't met.
+type ListArtifactsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListArtifactsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListArtifactsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListArtifactsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListArtifactsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListArtifactsRequestValidationError) ErrorName() string {
+	return "ListArtifactsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateCompileResponseValidationError) Error() string {
+func (e ListArtifactsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -841,14 +5756,14 @@ func (e CreateCompileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateCompileResponse.%s: %s%s",
+		"invalid %sListArtifactsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateCompileResponseValidationError{}
+var _ error = ListArtifactsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -856,46 +5771,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateCompileResponseValidationError{}
+} = ListArtifactsRequestValidationError{}
 
-// Validate checks the field values on GetCompileResultRequest with the rules
+// Validate checks the field values on ListArtifactsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCompileResultRequest) Validate() error {
+func (m *ListArtifactsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCompileResultRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListArtifactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCompileResultRequestMultiError, or nil if none found.
-func (m *GetCompileResultRequest) ValidateAll() error {
+// ListArtifactsResponseMultiError, or nil if none found.
+func (m *ListArtifactsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCompileResultRequest) validate(all bool) error {
+func (m *ListArtifactsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	for idx, item := range m.GetArtifacts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListArtifactsResponseValidationError{
+					field:  fmt.Sprintf("Artifacts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetCompileResultRequestMultiError(errors)
+		return ListArtifactsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCompileResultRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCompileResultRequest.ValidateAll() if the designated
+// ListArtifactsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListArtifactsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetCompileResultRequestMultiError []error
+type ListArtifactsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCompileResultRequestMultiError) Error() string {
+func (m ListArtifactsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -904,11 +5851,11 @@ func (m GetCompileResultRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCompileResultRequestMultiError) AllErrors() []error { return m }
+func (m ListArtifactsResponseMultiError) AllErrors() []error { return m }
 
-// GetCompileResultRequestValidationError is the validation error returned by
-// GetCompileResultRequest.Validate if the designated constraints aren't met.
-type GetCompileResultRequestValidationError struct {
+// ListArtifactsResponseValidationError is the validation error returned by
+// ListArtifactsResponse.Validate if the designated constraints aren't met.
+type ListArtifactsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -916,24 +5863,24 @@ type GetCompileResultRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCompileResultRequestValidationError) Field() string { return e.field }
+func (e ListArtifactsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCompileResultRequestValidationError) Reason() string { return e.reason }
+func (e ListArtifactsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCompileResultRequestValidationError) Cause() error { return e.cause }
+func (e ListArtifactsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCompileResultRequestValidationError) Key() bool { return e.key }
+func (e ListArtifactsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCompileResultRequestValidationError) ErrorName() string {
-	return "GetCompileResultRequestValidationError"
+func (e ListArtifactsResponseValidationError) ErrorName() string {
+	return "ListArtifactsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCompileResultRequestValidationError) Error() string {
+func (e ListArtifactsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -945,14 +5892,14 @@ func (e GetCompileResultRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCompileResultRequest.%s: %s%s",
+		"invalid %sListArtifactsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCompileResultRequestValidationError{}
+var _ error = ListArtifactsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -960,62 +5907,264 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCompileResultRequestValidationError{}
+} = ListArtifactsResponseValidationError{}
 
-// Validate checks the field values on GetCompileResultResponse with the rules
+// Validate checks the field values on Artifact with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Artifact) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Artifact with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ArtifactMultiError, or nil
+// if none found.
+func (m *Artifact) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Artifact) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for SizeBytes
+
+	if len(errors) > 0 {
+		return ArtifactMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArtifactMultiError is an error wrapping multiple validation errors returned
+// by Artifact.ValidateAll() if the designated constraints aren't met.
+type ArtifactMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArtifactMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArtifactMultiError) AllErrors() []error { return m }
+
+// ArtifactValidationError is the validation error returned by
+// Artifact.Validate if the designated constraints aren't met.
+type ArtifactValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArtifactValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArtifactValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArtifactValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArtifactValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArtifactValidationError) ErrorName() string { return "ArtifactValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ArtifactValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArtifact.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArtifactValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArtifactValidationError{}
+
+// Validate checks the field values on DownloadArtifactRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCompileResultResponse) Validate() error {
+func (m *DownloadArtifactRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCompileResultResponse with the
+// ValidateAll checks the field values on DownloadArtifactRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCompileResultResponseMultiError, or nil if none found.
-func (m *GetCompileResultResponse) ValidateAll() error {
+// DownloadArtifactRequestMultiError, or nil if none found.
+func (m *DownloadArtifactRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCompileResultResponse) validate(all bool) error {
+func (m *DownloadArtifactRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Language
+	// no validation rules for Id
 
-	// no validation rules for Status
+	if l := utf8.RuneCountInString(m.GetPath()); l < 1 || l > 255 {
+		err := DownloadArtifactRequestValidationError{
+			field:  "Path",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for TestStatus
+	if len(errors) > 0 {
+		return DownloadArtifactRequestMultiError(errors)
+	}
 
-	// no validation rules for CompileMs
+	return nil
+}
 
-	// no validation rules for RuntimeMs
+// DownloadArtifactRequestMultiError is an error wrapping multiple validation
+// errors returned by DownloadArtifactRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadArtifactRequestMultiError []error
 
-	// no validation rules for RuntimeMemoryMb
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadArtifactRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for Output
+// AllErrors returns a list of validation violation errors.
+func (m DownloadArtifactRequestMultiError) AllErrors() []error { return m }
 
-	// no validation rules for OutputError
+// DownloadArtifactRequestValidationError is the validation error returned by
+// DownloadArtifactRequest.Validate if the designated constraints aren't met.
+type DownloadArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for CompilerOutput
+// Field function returns field value.
+func (e DownloadArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadArtifactRequestValidationError) ErrorName() string {
+	return "DownloadArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadArtifactRequestValidationError{}
+
+// Validate checks the field values on DownloadArtifactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadArtifactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadArtifactResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadArtifactResponseMultiError, or nil if none found.
+func (m *DownloadArtifactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadArtifactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Chunk
 
 	if len(errors) > 0 {
-		return GetCompileResultResponseMultiError(errors)
+		return DownloadArtifactResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCompileResultResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCompileResultResponse.ValidateAll() if the designated
+// DownloadArtifactResponseMultiError is an error wrapping multiple validation
+// errors returned by DownloadArtifactResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetCompileResultResponseMultiError []error
+type DownloadArtifactResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCompileResultResponseMultiError) Error() string {
+func (m DownloadArtifactResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1024,11 +6173,11 @@ func (m GetCompileResultResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCompileResultResponseMultiError) AllErrors() []error { return m }
+func (m DownloadArtifactResponseMultiError) AllErrors() []error { return m }
 
-// GetCompileResultResponseValidationError is the validation error returned by
-// GetCompileResultResponse.Validate if the designated constraints aren't met.
-type GetCompileResultResponseValidationError struct {
+// DownloadArtifactResponseValidationError is the validation error returned by
+// DownloadArtifactResponse.Validate if the designated constraints aren't met.
+type DownloadArtifactResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1036,24 +6185,24 @@ type GetCompileResultResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCompileResultResponseValidationError) Field() string { return e.field }
+func (e DownloadArtifactResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCompileResultResponseValidationError) Reason() string { return e.reason }
+func (e DownloadArtifactResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCompileResultResponseValidationError) Cause() error { return e.cause }
+func (e DownloadArtifactResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCompileResultResponseValidationError) Key() bool { return e.key }
+func (e DownloadArtifactResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCompileResultResponseValidationError) ErrorName() string {
-	return "GetCompileResultResponseValidationError"
+func (e DownloadArtifactResponseValidationError) ErrorName() string {
+	return "DownloadArtifactResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCompileResultResponseValidationError) Error() string {
+func (e DownloadArtifactResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1065,14 +6214,14 @@ func (e GetCompileResultResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCompileResultResponse.%s: %s%s",
+		"invalid %sDownloadArtifactResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCompileResultResponseValidationError{}
+var _ error = DownloadArtifactResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1080,4 +6229,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCompileResultResponseValidationError{}
+} = DownloadArtifactResponseValidationError{}
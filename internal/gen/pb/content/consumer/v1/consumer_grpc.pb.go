@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
+// - protoc-gen-go-grpc v1.3.0
 // - protoc             (unknown)
 // source: content/consumer/v1/consumer.proto
 
@@ -20,6 +20,24 @@ import (
 // Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
+const (
+	ConsumerService_Ping_FullMethodName                  = "/content.consumer.v1.ConsumerService/Ping"
+	ConsumerService_GetTemplate_FullMethodName           = "/content.consumer.v1.ConsumerService/GetTemplate"
+	ConsumerService_ListTemplates_FullMethodName         = "/content.consumer.v1.ConsumerService/ListTemplates"
+	ConsumerService_GetSupportedLanguages_FullMethodName = "/content.consumer.v1.ConsumerService/GetSupportedLanguages"
+	ConsumerService_CreateCompile_FullMethodName         = "/content.consumer.v1.ConsumerService/CreateCompile"
+	ConsumerService_CompileAndWait_FullMethodName        = "/content.consumer.v1.ConsumerService/CompileAndWait"
+	ConsumerService_GetCompileResult_FullMethodName      = "/content.consumer.v1.ConsumerService/GetCompileResult"
+	ConsumerService_WatchCompileResult_FullMethodName    = "/content.consumer.v1.ConsumerService/WatchCompileResult"
+	ConsumerService_CancelCompile_FullMethodName         = "/content.consumer.v1.ConsumerService/CancelCompile"
+	ConsumerService_ListExecutions_FullMethodName        = "/content.consumer.v1.ConsumerService/ListExecutions"
+	ConsumerService_CreateCompileBatch_FullMethodName    = "/content.consumer.v1.ConsumerService/CreateCompileBatch"
+	ConsumerService_GetBatchResult_FullMethodName        = "/content.consumer.v1.ConsumerService/GetBatchResult"
+	ConsumerService_UploadSource_FullMethodName          = "/content.consumer.v1.ConsumerService/UploadSource"
+	ConsumerService_ListArtifacts_FullMethodName         = "/content.consumer.v1.ConsumerService/ListArtifacts"
+	ConsumerService_DownloadArtifact_FullMethodName      = "/content.consumer.v1.ConsumerService/DownloadArtifact"
+)
+
 // ConsumerServiceClient is the client API for ConsumerService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -31,6 +49,10 @@ type ConsumerServiceClient interface {
 	// languages that require selective formatting or a main function. An example
 	// of these languages would be C++, and C.
 	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
+	// ListTemplates returns the variants of the templates available for every
+	// language, or a single language if provided. The default template of a
+	// language is returned as the "default" variant.
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
 	// GetSupportedLanguages will return a list of languages that can be exposed
 	// to the user. This response contains a display name for the language that
 	// will contain compiler information if important and will also return the
@@ -40,10 +62,48 @@ type ConsumerServiceClient interface {
 	// CompileQueueRequest is the core compile request endpoint. Calling into this
 	// will trigger the flow to run the user-submitted code.
 	CreateCompile(ctx context.Context, in *CreateCompileRequest, opts ...grpc.CallOption) (*CreateCompileResponse, error)
+	// CompileAndWait enqueues the compile request exactly like CreateCompile but
+	// blocks until the execution has completed, returning the complete compile
+	// result. If the deadline of the call is reached first, the id and current
+	// status is returned instead allowing the caller to fall back to polling.
+	CompileAndWait(ctx context.Context, in *CompileAndWaitRequest, opts ...grpc.CallOption) (*CompileAndWaitResponse, error)
 	// GetCompileResultRequest is required to be called after requesting to
 	// compile, all details about the running state and the final output
 	// of the compiling and execution are from this.
 	GetCompileResult(ctx context.Context, in *GetCompileResultRequest, opts ...grpc.CallOption) (*GetCompileResultResponse, error)
+	// WatchCompileResult streams every status transition of a compile request
+	// as it happens, removing the need to poll GetCompileResult. The final
+	// message of the stream contains the complete compile result once the
+	// execution reaches a terminal state.
+	WatchCompileResult(ctx context.Context, in *WatchCompileResultRequest, opts ...grpc.CallOption) (ConsumerService_WatchCompileResultClient, error)
+	// CancelCompile aborts a compile request. Requests still waiting in the
+	// queue will be skipped by the loader and requests currently running will
+	// have their container killed. Requests which have already completed cannot
+	// be cancelled.
+	CancelCompile(ctx context.Context, in *CancelCompileRequest, opts ...grpc.CallOption) (*CancelCompileResponse, error)
+	// ListExecutions returns a page of the compile requests ordered by the newest
+	// first, optionally filtered by language, status, test status and the time
+	// range in which they were created. Use the returned next page token to
+	// request the following page.
+	ListExecutions(ctx context.Context, in *ListExecutionsRequest, opts ...grpc.CallOption) (*ListExecutionsResponse, error)
+	// CreateCompileBatch accepts many compile requests at once, for example all
+	// the submissions of an assignment. Every request is enqueued the same as
+	// CreateCompile and the batch id can be used to get the progress of the
+	// entire batch.
+	CreateCompileBatch(ctx context.Context, in *CreateCompileBatchRequest, opts ...grpc.CallOption) (*CreateCompileBatchResponse, error)
+	// GetBatchResult returns the progress of a batch, including the number of
+	// executions by status and test status and the completion percentage.
+	GetBatchResult(ctx context.Context, in *GetBatchResultRequest, opts ...grpc.CallOption) (*GetBatchResultResponse, error)
+	// UploadSource accepts a source too large to be sent inline as a stream of
+	// chunks. The first message must contain the metadata of the source, every
+	// following message a chunk of its bytes. The returned source id can be used
+	// by CreateCompile instead of the source or the files.
+	UploadSource(ctx context.Context, opts ...grpc.CallOption) (ConsumerService_UploadSourceClient, error)
+	// ListArtifacts returns the files the code wrote into the artifacts
+	// directory, /input/artifacts, and the compiled binary if requested.
+	ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error)
+	// DownloadArtifact streams the content of a single artifact in chunks.
+	DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (ConsumerService_DownloadArtifactClient, error)
 }
 
 type consumerServiceClient struct {
@@ -56,7 +116,7 @@ func NewConsumerServiceClient(cc grpc.ClientConnInterface) ConsumerServiceClient
 
 func (c *consumerServiceClient) Ping(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PingResponse, error) {
 	out := new(PingResponse)
-	err := c.cc.Invoke(ctx, "/content.consumer.v1.ConsumerService/Ping", in, out, opts...)
+	err := c.cc.Invoke(ctx, ConsumerService_Ping_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +125,16 @@ func (c *consumerServiceClient) Ping(ctx context.Context, in *emptypb.Empty, opt
 
 func (c *consumerServiceClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error) {
 	out := new(GetTemplateResponse)
-	err := c.cc.Invoke(ctx, "/content.consumer.v1.ConsumerService/GetTemplate", in, out, opts...)
+	err := c.cc.Invoke(ctx, ConsumerService_GetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, ConsumerService_ListTemplates_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +143,7 @@ func (c *consumerServiceClient) GetTemplate(ctx context.Context, in *GetTemplate
 
 func (c *consumerServiceClient) GetSupportedLanguages(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSupportedLanguagesResponse, error) {
 	out := new(GetSupportedLanguagesResponse)
-	err := c.cc.Invoke(ctx, "/content.consumer.v1.ConsumerService/GetSupportedLanguages", in, out, opts...)
+	err := c.cc.Invoke(ctx, ConsumerService_GetSupportedLanguages_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +152,16 @@ func (c *consumerServiceClient) GetSupportedLanguages(ctx context.Context, in *e
 
 func (c *consumerServiceClient) CreateCompile(ctx context.Context, in *CreateCompileRequest, opts ...grpc.CallOption) (*CreateCompileResponse, error) {
 	out := new(CreateCompileResponse)
-	err := c.cc.Invoke(ctx, "/content.consumer.v1.ConsumerService/CreateCompile", in, out, opts...)
+	err := c.cc.Invoke(ctx, ConsumerService_CreateCompile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerServiceClient) CompileAndWait(ctx context.Context, in *CompileAndWaitRequest, opts ...grpc.CallOption) (*CompileAndWaitResponse, error) {
+	out := new(CompileAndWaitResponse)
+	err := c.cc.Invoke(ctx, ConsumerService_CompileAndWait_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -92,13 +170,156 @@ func (c *consumerServiceClient) CreateCompile(ctx context.Context, in *CreateCom
 
 func (c *consumerServiceClient) GetCompileResult(ctx context.Context, in *GetCompileResultRequest, opts ...grpc.CallOption) (*GetCompileResultResponse, error) {
 	out := new(GetCompileResultResponse)
-	err := c.cc.Invoke(ctx, "/content.consumer.v1.ConsumerService/GetCompileResult", in, out, opts...)
+	err := c.cc.Invoke(ctx, ConsumerService_GetCompileResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerServiceClient) WatchCompileResult(ctx context.Context, in *WatchCompileResultRequest, opts ...grpc.CallOption) (ConsumerService_WatchCompileResultClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConsumerService_ServiceDesc.Streams[0], ConsumerService_WatchCompileResult_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &consumerServiceWatchCompileResultClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConsumerService_WatchCompileResultClient interface {
+	Recv() (*WatchCompileResultResponse, error)
+	grpc.ClientStream
+}
+
+type consumerServiceWatchCompileResultClient struct {
+	grpc.ClientStream
+}
+
+func (x *consumerServiceWatchCompileResultClient) Recv() (*WatchCompileResultResponse, error) {
+	m := new(WatchCompileResultResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *consumerServiceClient) CancelCompile(ctx context.Context, in *CancelCompileRequest, opts ...grpc.CallOption) (*CancelCompileResponse, error) {
+	out := new(CancelCompileResponse)
+	err := c.cc.Invoke(ctx, ConsumerService_CancelCompile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerServiceClient) ListExecutions(ctx context.Context, in *ListExecutionsRequest, opts ...grpc.CallOption) (*ListExecutionsResponse, error) {
+	out := new(ListExecutionsResponse)
+	err := c.cc.Invoke(ctx, ConsumerService_ListExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerServiceClient) CreateCompileBatch(ctx context.Context, in *CreateCompileBatchRequest, opts ...grpc.CallOption) (*CreateCompileBatchResponse, error) {
+	out := new(CreateCompileBatchResponse)
+	err := c.cc.Invoke(ctx, ConsumerService_CreateCompileBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerServiceClient) GetBatchResult(ctx context.Context, in *GetBatchResultRequest, opts ...grpc.CallOption) (*GetBatchResultResponse, error) {
+	out := new(GetBatchResultResponse)
+	err := c.cc.Invoke(ctx, ConsumerService_GetBatchResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerServiceClient) UploadSource(ctx context.Context, opts ...grpc.CallOption) (ConsumerService_UploadSourceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConsumerService_ServiceDesc.Streams[1], ConsumerService_UploadSource_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &consumerServiceUploadSourceClient{stream}
+	return x, nil
+}
+
+type ConsumerService_UploadSourceClient interface {
+	Send(*UploadSourceRequest) error
+	CloseAndRecv() (*UploadSourceResponse, error)
+	grpc.ClientStream
+}
+
+type consumerServiceUploadSourceClient struct {
+	grpc.ClientStream
+}
+
+func (x *consumerServiceUploadSourceClient) Send(m *UploadSourceRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *consumerServiceUploadSourceClient) CloseAndRecv() (*UploadSourceResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadSourceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *consumerServiceClient) ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error) {
+	out := new(ListArtifactsResponse)
+	err := c.cc.Invoke(ctx, ConsumerService_ListArtifacts_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
+func (c *consumerServiceClient) DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (ConsumerService_DownloadArtifactClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConsumerService_ServiceDesc.Streams[2], ConsumerService_DownloadArtifact_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &consumerServiceDownloadArtifactClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConsumerService_DownloadArtifactClient interface {
+	Recv() (*DownloadArtifactResponse, error)
+	grpc.ClientStream
+}
+
+type consumerServiceDownloadArtifactClient struct {
+	grpc.ClientStream
+}
+
+func (x *consumerServiceDownloadArtifactClient) Recv() (*DownloadArtifactResponse, error) {
+	m := new(DownloadArtifactResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ConsumerServiceServer is the server API for ConsumerService service.
 // All implementations should embed UnimplementedConsumerServiceServer
 // for forward compatibility
@@ -110,6 +331,10 @@ type ConsumerServiceServer interface {
 	// languages that require selective formatting or a main function. An example
 	// of these languages would be C++, and C.
 	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error)
+	// ListTemplates returns the variants of the templates available for every
+	// language, or a single language if provided. The default template of a
+	// language is returned as the "default" variant.
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
 	// GetSupportedLanguages will return a list of languages that can be exposed
 	// to the user. This response contains a display name for the language that
 	// will contain compiler information if important and will also return the
@@ -119,10 +344,48 @@ type ConsumerServiceServer interface {
 	// CompileQueueRequest is the core compile request endpoint. Calling into this
 	// will trigger the flow to run the user-submitted code.
 	CreateCompile(context.Context, *CreateCompileRequest) (*CreateCompileResponse, error)
+	// CompileAndWait enqueues the compile request exactly like CreateCompile but
+	// blocks until the execution has completed, returning the complete compile
+	// result. If the deadline of the call is reached first, the id and current
+	// status is returned instead allowing the caller to fall back to polling.
+	CompileAndWait(context.Context, *CompileAndWaitRequest) (*CompileAndWaitResponse, error)
 	// GetCompileResultRequest is required to be called after requesting to
 	// compile, all details about the running state and the final output
 	// of the compiling and execution are from this.
 	GetCompileResult(context.Context, *GetCompileResultRequest) (*GetCompileResultResponse, error)
+	// WatchCompileResult streams every status transition of a compile request
+	// as it happens, removing the need to poll GetCompileResult. The final
+	// message of the stream contains the complete compile result once the
+	// execution reaches a terminal state.
+	WatchCompileResult(*WatchCompileResultRequest, ConsumerService_WatchCompileResultServer) error
+	// CancelCompile aborts a compile request. Requests still waiting in the
+	// queue will be skipped by the loader and requests currently running will
+	// have their container killed. Requests which have already completed cannot
+	// be cancelled.
+	CancelCompile(context.Context, *CancelCompileRequest) (*CancelCompileResponse, error)
+	// ListExecutions returns a page of the compile requests ordered by the newest
+	// first, optionally filtered by language, status, test status and the time
+	// range in which they were created. Use the returned next page token to
+	// request the following page.
+	ListExecutions(context.Context, *ListExecutionsRequest) (*ListExecutionsResponse, error)
+	// CreateCompileBatch accepts many compile requests at once, for example all
+	// the submissions of an assignment. Every request is enqueued the same as
+	// CreateCompile and the batch id can be used to get the progress of the
+	// entire batch.
+	CreateCompileBatch(context.Context, *CreateCompileBatchRequest) (*CreateCompileBatchResponse, error)
+	// GetBatchResult returns the progress of a batch, including the number of
+	// executions by status and test status and the completion percentage.
+	GetBatchResult(context.Context, *GetBatchResultRequest) (*GetBatchResultResponse, error)
+	// UploadSource accepts a source too large to be sent inline as a stream of
+	// chunks. The first message must contain the metadata of the source, every
+	// following message a chunk of its bytes. The returned source id can be used
+	// by CreateCompile instead of the source or the files.
+	UploadSource(ConsumerService_UploadSourceServer) error
+	// ListArtifacts returns the files the code wrote into the artifacts
+	// directory, /input/artifacts, and the compiled binary if requested.
+	ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error)
+	// DownloadArtifact streams the content of a single artifact in chunks.
+	DownloadArtifact(*DownloadArtifactRequest, ConsumerService_DownloadArtifactServer) error
 }
 
 // UnimplementedConsumerServiceServer should be embedded to have forward compatible implementations.
@@ -135,15 +398,45 @@ func (UnimplementedConsumerServiceServer) Ping(context.Context, *emptypb.Empty)
 func (UnimplementedConsumerServiceServer) GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
 }
+func (UnimplementedConsumerServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
 func (UnimplementedConsumerServiceServer) GetSupportedLanguages(context.Context, *emptypb.Empty) (*GetSupportedLanguagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSupportedLanguages not implemented")
 }
 func (UnimplementedConsumerServiceServer) CreateCompile(context.Context, *CreateCompileRequest) (*CreateCompileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCompile not implemented")
 }
+func (UnimplementedConsumerServiceServer) CompileAndWait(context.Context, *CompileAndWaitRequest) (*CompileAndWaitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompileAndWait not implemented")
+}
 func (UnimplementedConsumerServiceServer) GetCompileResult(context.Context, *GetCompileResultRequest) (*GetCompileResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompileResult not implemented")
 }
+func (UnimplementedConsumerServiceServer) WatchCompileResult(*WatchCompileResultRequest, ConsumerService_WatchCompileResultServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCompileResult not implemented")
+}
+func (UnimplementedConsumerServiceServer) CancelCompile(context.Context, *CancelCompileRequest) (*CancelCompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompile not implemented")
+}
+func (UnimplementedConsumerServiceServer) ListExecutions(context.Context, *ListExecutionsRequest) (*ListExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExecutions not implemented")
+}
+func (UnimplementedConsumerServiceServer) CreateCompileBatch(context.Context, *CreateCompileBatchRequest) (*CreateCompileBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompileBatch not implemented")
+}
+func (UnimplementedConsumerServiceServer) GetBatchResult(context.Context, *GetBatchResultRequest) (*GetBatchResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchResult not implemented")
+}
+func (UnimplementedConsumerServiceServer) UploadSource(ConsumerService_UploadSourceServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadSource not implemented")
+}
+func (UnimplementedConsumerServiceServer) ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArtifacts not implemented")
+}
+func (UnimplementedConsumerServiceServer) DownloadArtifact(*DownloadArtifactRequest, ConsumerService_DownloadArtifactServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadArtifact not implemented")
+}
 
 // UnsafeConsumerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ConsumerServiceServer will
@@ -166,7 +459,7 @@ func _ConsumerService_Ping_Handler(srv interface{}, ctx context.Context, dec fun
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/content.consumer.v1.ConsumerService/Ping",
+		FullMethod: ConsumerService_Ping_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ConsumerServiceServer).Ping(ctx, req.(*emptypb.Empty))
@@ -184,7 +477,7 @@ func _ConsumerService_GetTemplate_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/content.consumer.v1.ConsumerService/GetTemplate",
+		FullMethod: ConsumerService_GetTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ConsumerServiceServer).GetTemplate(ctx, req.(*GetTemplateRequest))
@@ -192,6 +485,24 @@ func _ConsumerService_GetTemplate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConsumerService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsumerService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConsumerService_GetSupportedLanguages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -202,7 +513,7 @@ func _ConsumerService_GetSupportedLanguages_Handler(srv interface{}, ctx context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/content.consumer.v1.ConsumerService/GetSupportedLanguages",
+		FullMethod: ConsumerService_GetSupportedLanguages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ConsumerServiceServer).GetSupportedLanguages(ctx, req.(*emptypb.Empty))
@@ -220,7 +531,7 @@ func _ConsumerService_CreateCompile_Handler(srv interface{}, ctx context.Context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/content.consumer.v1.ConsumerService/CreateCompile",
+		FullMethod: ConsumerService_CreateCompile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ConsumerServiceServer).CreateCompile(ctx, req.(*CreateCompileRequest))
@@ -228,6 +539,24 @@ func _ConsumerService_CreateCompile_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConsumerService_CompileAndWait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompileAndWaitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServiceServer).CompileAndWait(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsumerService_CompileAndWait_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServiceServer).CompileAndWait(ctx, req.(*CompileAndWaitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConsumerService_GetCompileResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCompileResultRequest)
 	if err := dec(in); err != nil {
@@ -238,7 +567,7 @@ func _ConsumerService_GetCompileResult_Handler(srv interface{}, ctx context.Cont
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/content.consumer.v1.ConsumerService/GetCompileResult",
+		FullMethod: ConsumerService_GetCompileResult_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ConsumerServiceServer).GetCompileResult(ctx, req.(*GetCompileResultRequest))
@@ -246,6 +575,164 @@ func _ConsumerService_GetCompileResult_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConsumerService_WatchCompileResult_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCompileResultRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConsumerServiceServer).WatchCompileResult(m, &consumerServiceWatchCompileResultServer{stream})
+}
+
+type ConsumerService_WatchCompileResultServer interface {
+	Send(*WatchCompileResultResponse) error
+	grpc.ServerStream
+}
+
+type consumerServiceWatchCompileResultServer struct {
+	grpc.ServerStream
+}
+
+func (x *consumerServiceWatchCompileResultServer) Send(m *WatchCompileResultResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ConsumerService_CancelCompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCompileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServiceServer).CancelCompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsumerService_CancelCompile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServiceServer).CancelCompile(ctx, req.(*CancelCompileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsumerService_ListExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServiceServer).ListExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsumerService_ListExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServiceServer).ListExecutions(ctx, req.(*ListExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsumerService_CreateCompileBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompileBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServiceServer).CreateCompileBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsumerService_CreateCompileBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServiceServer).CreateCompileBatch(ctx, req.(*CreateCompileBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsumerService_GetBatchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServiceServer).GetBatchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsumerService_GetBatchResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServiceServer).GetBatchResult(ctx, req.(*GetBatchResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsumerService_UploadSource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ConsumerServiceServer).UploadSource(&consumerServiceUploadSourceServer{stream})
+}
+
+type ConsumerService_UploadSourceServer interface {
+	SendAndClose(*UploadSourceResponse) error
+	Recv() (*UploadSourceRequest, error)
+	grpc.ServerStream
+}
+
+type consumerServiceUploadSourceServer struct {
+	grpc.ServerStream
+}
+
+func (x *consumerServiceUploadSourceServer) SendAndClose(m *UploadSourceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *consumerServiceUploadSourceServer) Recv() (*UploadSourceRequest, error) {
+	m := new(UploadSourceRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ConsumerService_ListArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServiceServer).ListArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsumerService_ListArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServiceServer).ListArtifacts(ctx, req.(*ListArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsumerService_DownloadArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadArtifactRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConsumerServiceServer).DownloadArtifact(m, &consumerServiceDownloadArtifactServer{stream})
+}
+
+type ConsumerService_DownloadArtifactServer interface {
+	Send(*DownloadArtifactResponse) error
+	grpc.ServerStream
+}
+
+type consumerServiceDownloadArtifactServer struct {
+	grpc.ServerStream
+}
+
+func (x *consumerServiceDownloadArtifactServer) Send(m *DownloadArtifactResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ConsumerService_ServiceDesc is the grpc.ServiceDesc for ConsumerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +748,10 @@ var ConsumerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTemplate",
 			Handler:    _ConsumerService_GetTemplate_Handler,
 		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _ConsumerService_ListTemplates_Handler,
+		},
 		{
 			MethodName: "GetSupportedLanguages",
 			Handler:    _ConsumerService_GetSupportedLanguages_Handler,
@@ -269,11 +760,51 @@ var ConsumerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateCompile",
 			Handler:    _ConsumerService_CreateCompile_Handler,
 		},
+		{
+			MethodName: "CompileAndWait",
+			Handler:    _ConsumerService_CompileAndWait_Handler,
+		},
 		{
 			MethodName: "GetCompileResult",
 			Handler:    _ConsumerService_GetCompileResult_Handler,
 		},
+		{
+			MethodName: "CancelCompile",
+			Handler:    _ConsumerService_CancelCompile_Handler,
+		},
+		{
+			MethodName: "ListExecutions",
+			Handler:    _ConsumerService_ListExecutions_Handler,
+		},
+		{
+			MethodName: "CreateCompileBatch",
+			Handler:    _ConsumerService_CreateCompileBatch_Handler,
+		},
+		{
+			MethodName: "GetBatchResult",
+			Handler:    _ConsumerService_GetBatchResult_Handler,
+		},
+		{
+			MethodName: "ListArtifacts",
+			Handler:    _ConsumerService_ListArtifacts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCompileResult",
+			Handler:       _ConsumerService_WatchCompileResult_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadSource",
+			Handler:       _ConsumerService_UploadSource_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadArtifact",
+			Handler:       _ConsumerService_DownloadArtifact_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "content/consumer/v1/consumer.proto",
 }
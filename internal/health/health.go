@@ -0,0 +1,149 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Check is a single readiness check of a dependency, returning an error if
+// the dependency cannot currently be used.
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Result is the outcome of a single readiness check.
+type Result struct {
+	Name  string `json:"name"`
+	Ready bool   `json:"ready"`
+	Error string `json:"error,omitempty"`
+}
+
+// Checker runs the registered readiness checks, the service is only ready once
+// every check succeeds.
+type Checker struct {
+	// timeout is the maximum time all checks can take before being considered
+	// failed.
+	timeout time.Duration
+	checks  []namedCheck
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Register adds the check under the given name.
+func (c *Checker) Register(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Check runs every check concurrently, returning the results ordered by the
+// name and true if every check succeeded.
+func (c *Checker) Check(ctx context.Context) ([]Result, bool) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	results := make([]Result, len(c.checks))
+	wg := sync.WaitGroup{}
+
+	for i, check := range c.checks {
+		wg.Add(1)
+
+		go func(i int, check namedCheck) {
+			defer wg.Done()
+
+			results[i] = Result{Name: check.name, Ready: true}
+
+			if err := check.check(ctx); err != nil {
+				results[i].Ready = false
+				results[i].Error = err.Error()
+			}
+		}(i, check)
+	}
+
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+
+	ready := true
+
+	for _, result := range results {
+		ready = ready && result.Ready
+	}
+
+	return results, ready
+}
+
+// Handler returns the http handler serving the liveness probe on /healthz,
+// which succeeds as long as the service is running, and the readiness probe
+// on /readyz, which only succeeds if every check succeeded.
+func (c *Checker) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		results, ready := c.Check(r.Context())
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		_ = json.NewEncoder(w).Encode(struct {
+			Ready  bool     `json:"ready"`
+			Checks []Result `json:"checks"`
+		}{Ready: ready, Checks: results})
+	})
+
+	return mux
+}
+
+// Watch runs the checks every interval until the context is done, updating
+// the serving status of the service and the overall server status within the
+// gRPC health server.
+func (c *Checker) Watch(ctx context.Context, server *health.Server, service string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		results, ready := c.Check(ctx)
+		status := healthpb.HealthCheckResponse_SERVING
+
+		if !ready {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+
+			for _, result := range results {
+				if !result.Ready {
+					log.Warn().Str("check", result.Name).Str("error", result.Error).Msg("readiness check failed")
+				}
+			}
+		}
+
+		server.SetServingStatus("", status)
+		server.SetServingStatus(service, status)
+
+		select {
+		case <-ctx.Done():
+			server.Shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
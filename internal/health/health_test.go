@@ -0,0 +1,108 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestCheckerCheck(t *testing.T) {
+	checker := NewChecker(time.Second)
+	checker.Register("queue", func(context.Context) error { return nil })
+	checker.Register("docker", func(context.Context) error { return fmt.Errorf("daemon not running") })
+
+	results, ready := checker.Check(context.Background())
+
+	assert.False(t, ready)
+	assert.Equal(t, []Result{
+		{Name: "docker", Ready: false, Error: "daemon not running"},
+		{Name: "queue", Ready: true},
+	}, results)
+}
+
+func TestCheckerCheckTimeout(t *testing.T) {
+	checker := NewChecker(time.Millisecond * 10)
+	checker.Register("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	_, ready := checker.Check(context.Background())
+	assert.False(t, ready)
+}
+
+func TestCheckerHandler(t *testing.T) {
+	healthy := true
+
+	checker := NewChecker(time.Second)
+	checker.Register("repository", func(context.Context) error {
+		if healthy {
+			return nil
+		}
+
+		return fmt.Errorf("connection refused")
+	})
+
+	server := httptest.NewServer(checker.Handler())
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		healthy    bool
+		path       string
+		statusCode int
+	}{
+		{name: "live while healthy", healthy: true, path: "/healthz", statusCode: http.StatusOK},
+		{name: "live while unhealthy", healthy: false, path: "/healthz", statusCode: http.StatusOK},
+		{name: "ready while healthy", healthy: true, path: "/readyz", statusCode: http.StatusOK},
+		{name: "not ready while unhealthy", healthy: false, path: "/readyz", statusCode: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			healthy = tt.healthy
+
+			resp, err := http.Get(server.URL + tt.path)
+			assert.NoError(t, err)
+
+			defer resp.Body.Close()
+			assert.Equal(t, tt.statusCode, resp.StatusCode)
+
+			if tt.path == "/readyz" {
+				var body struct {
+					Ready  bool     `json:"ready"`
+					Checks []Result `json:"checks"`
+				}
+
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+				assert.Equal(t, tt.healthy, body.Ready)
+				assert.Len(t, body.Checks, 1)
+			}
+		})
+	}
+}
+
+func TestCheckerWatch(t *testing.T) {
+	checker := NewChecker(time.Second)
+	checker.Register("queue", func(context.Context) error { return fmt.Errorf("not connected") })
+
+	server := health.NewServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go checker.Watch(ctx, server, "service", time.Millisecond*10)
+
+	assert.Eventually(t, func() bool {
+		resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "service"})
+		return err == nil && resp.Status == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, time.Millisecond*10)
+}
@@ -1,6 +1,8 @@
 package parser
 
 import (
+	"time"
+
 	"github.com/namsral/flag"
 	"github.com/rs/zerolog/log"
 )
@@ -11,6 +13,33 @@ type Arguments struct {
 	WaitTimeSeconds         int
 	SqsQueue                string
 	S3BucketName            string
+	ListenAddress           string
+	GatewayAddress          string
+	HealthAddress           string
+	TemplatesDirectory      string
+
+	RateLimitRequestsPerSecond float64
+	RateLimitBurst             int
+	MaxInFlightExecutions      int64
+
+	SourceUploadMaxBytes int64
+	SourceUploadMaxFiles int
+
+	TLSCertFile     string
+	TLSKeyFile      string
+	TLSClientCAFile string
+
+	TLSGatewayCertFile string
+	TLSGatewayKeyFile  string
+
+	WebhookSecret         string
+	WebhookMaxAttempts    int
+	WebhookInitialBackoff time.Duration
+	WebhookTimeout        time.Duration
+
+	// WebhookAllowPrivateNetworks allows callback urls of loopback,
+	// link-local and private addresses, only intended for local development.
+	WebhookAllowPrivateNetworks bool
 
 	NsqAddress string
 	NsqChannel string
@@ -26,6 +55,29 @@ func ParseDefaultConfigurationArguments() Arguments {
 	flag.IntVar(&args.MaxConcurrentContainers, "wait-time-seconds", 10, "")
 	flag.StringVar(&args.SqsQueue, "sqs-queue", "", "")
 	flag.StringVar(&args.S3BucketName, "s3-bucket", "", "")
+	flag.StringVar(&args.ListenAddress, "listen-address", ":8080", "")
+	flag.StringVar(&args.GatewayAddress, "gateway-address", ":8081", "")
+	flag.StringVar(&args.HealthAddress, "health-address", ":8082", "")
+	flag.StringVar(&args.TemplatesDirectory, "templates-directory", "", "")
+
+	flag.Float64Var(&args.RateLimitRequestsPerSecond, "rate-limit-requests-per-second", 10, "")
+	flag.IntVar(&args.RateLimitBurst, "rate-limit-burst", 20, "")
+	flag.Int64Var(&args.MaxInFlightExecutions, "max-in-flight-executions", 50, "")
+
+	flag.Int64Var(&args.SourceUploadMaxBytes, "source-upload-max-bytes", 10*1024*1024, "")
+	flag.IntVar(&args.SourceUploadMaxFiles, "source-upload-max-files", 100, "")
+
+	flag.StringVar(&args.TLSCertFile, "tls-cert-file", "", "")
+	flag.StringVar(&args.TLSKeyFile, "tls-key-file", "", "")
+	flag.StringVar(&args.TLSClientCAFile, "tls-client-ca-file", "", "")
+	flag.StringVar(&args.TLSGatewayCertFile, "tls-gateway-cert-file", "", "")
+	flag.StringVar(&args.TLSGatewayKeyFile, "tls-gateway-key-file", "", "")
+
+	flag.StringVar(&args.WebhookSecret, "webhook-secret", "", "")
+	flag.IntVar(&args.WebhookMaxAttempts, "webhook-max-attempts", 5, "")
+	flag.DurationVar(&args.WebhookInitialBackoff, "webhook-initial-backoff", time.Second, "")
+	flag.DurationVar(&args.WebhookTimeout, "webhook-timeout", time.Second*10, "")
+	flag.BoolVar(&args.WebhookAllowPrivateNetworks, "webhook-allow-private-networks", false, "")
 
 	flag.StringVar(&args.NsqAddress, "nsq-address", "nsqd", "")
 	flag.StringVar(&args.NsqChannel, "nsq-channel", "main", "")
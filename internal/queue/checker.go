@@ -0,0 +1,72 @@
+package queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"compile-and-run-sandbox/internal/files"
+	"compile-and-run-sandbox/internal/sandbox"
+)
+
+// runChecker executes the checker of the compile message against the output
+// of every test case which finished running, replacing the verdicts of the
+// test cases of the response with the verdicts of the checker.
+func runChecker(manager *sandbox.ContainerManager, fileHandler files.Files, compileMsg *CompileMessage,
+	request *sandbox.Request, resp *sandbox.Response) {
+	compiler := sandbox.Compilers[compileMsg.Checker.Language]
+	checker := &sandbox.Checker{Compiler: compiler}
+
+	checkerRequest, checked := sandbox.NewCheckerRequest(request, resp, checker)
+
+	if len(checked) == 0 {
+		return
+	}
+
+	checkerResp, err := executeChecker(manager, fileHandler, compileMsg.ID, checkerRequest)
+
+	if err != nil {
+		log.Error().Err(err).Str("id", compileMsg.ID).Msg("failed to run checker")
+	}
+
+	sandbox.ApplyCheckerResponse(resp, checked, checkerResp)
+}
+
+// executeChecker runs the checker request in its own container, returning the
+// response once the container has completed.
+func executeChecker(manager *sandbox.ContainerManager, fileHandler files.Files, id string,
+	checkerRequest *sandbox.Request) (*sandbox.Response, error) {
+	sourceCode, err := fileHandler.GetFile(id, sandbox.CheckerFileName(checkerRequest.Compiler.SourceFile))
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get checker source")
+	}
+
+	checkerRequest.SourceCode = string(sourceCode)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	containerID, complete, err := manager.AddContainer(ctx, checkerRequest)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add checker container to Manager")
+	}
+
+	maxTimeout := checkerRequest.ExecutionProfile.CodeTimeout*time.Duration(len(checkerRequest.Checks)) +
+		checkerRequest.ExecutionProfile.CompileTimeout
+
+	select {
+	case <-complete:
+	case <-time.After(maxTimeout):
+		_ = manager.RemoveContainer(context.Background(), containerID, true)
+		return nil, errors.Errorf("checker execution exceeded %s", maxTimeout)
+	}
+
+	checkerResp := manager.GetResponse(ctx, containerID)
+	_ = manager.RemoveContainer(context.Background(), containerID, false)
+
+	return checkerResp, nil
+}
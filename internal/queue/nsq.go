@@ -8,6 +8,10 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// nsqMaxBatchEntries is the number of messages published within a single
+// multi publish, a failed chunk is retried one message at a time.
+const nsqMaxBatchEntries = 50
+
 type NsqQueue struct {
 	config *NsqConfig
 
@@ -42,13 +46,34 @@ func newNsqQueue(config *NsqConfig) (NsqQueue, error) {
 }
 
 func (n NsqQueue) HandleIncomingRequest(data []byte) error {
-	return handleNewCompileRequest(data, n.config.Manager, n.config.Repo, n.config.FilesHandler)
+	return handleNewCompileRequest(data, n.config.Manager, n.config.Repo, n.config.FilesHandler, n.config.Webhooks)
 }
 
 func (n NsqQueue) SubmitMessageToQueue(data []byte) error {
 	return n.producer.Publish(n.config.Topic, data)
 }
 
+func (n NsqQueue) SubmitMessagesToQueue(data [][]byte) error {
+	return submitInChunks(data, nsqMaxBatchEntries,
+		func(chunk [][]byte) error { return n.producer.MultiPublish(n.config.Topic, chunk) },
+		func(message []byte) error { return n.producer.Publish(n.config.Topic, message) },
+	)
+}
+
+func (n NsqQueue) Ping() error {
+	if n.producer != nil {
+		if err := n.producer.Ping(); err != nil {
+			return errors.Wrap(err, "nsq producer is not connected")
+		}
+	}
+
+	if n.consumer != nil && n.consumer.Stats().Connections == 0 {
+		return fmt.Errorf("nsq consumer has no connections")
+	}
+
+	return nil
+}
+
 func (n NsqQueue) Stop() {
 	log.Info().Msg("stopping NSQ consumer")
 	n.consumer.Stop()
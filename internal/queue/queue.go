@@ -3,8 +3,10 @@ package queue
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,17 +14,92 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"compile-and-run-sandbox/internal/files"
+	"compile-and-run-sandbox/internal/memory"
 	"compile-and-run-sandbox/internal/repository"
 	"compile-and-run-sandbox/internal/sandbox"
+	"compile-and-run-sandbox/internal/webhook"
 )
 
 type CompileMessage struct {
-	ID                 string   `json:"id"`
-	Language           string   `json:"language"`
+	ID        string            `json:"id"`
+	Language  string            `json:"language"`
+	TestCases []CompileTestCase `json:"test_cases"`
+
+	// The optional limits of the request, zero values fall back to the limits
+	// of the execution profile.
+	TimeLimitMs        int64 `json:"time_limit_ms"`
+	CompileTimeLimitMs int64 `json:"compile_time_limit_ms"`
+	MemoryLimitMb      int64 `json:"memory_limit_mb"`
+
+	// CallbackURL is the optional url the final result is sent to once the
+	// execution has completed.
+	CallbackURL string `json:"callback_url"`
+
+	// The paths of the files of a multi-file submission and the file
+	// containing the entry point, the files are stored within the project
+	// directory of the execution.
+	Files     []string `json:"files"`
+	EntryFile string   `json:"entry_file"`
+
+	// SourceID is the id of the uploaded source the files are stored under,
+	// if not set the files are stored under the id of the execution.
+	SourceID string `json:"source_id"`
+
+	// IncludeBinary is true if the compiled binary should be collected as an
+	// artifact of the execution.
+	IncludeBinary bool `json:"include_binary"`
+
+	// IncludeDiff is true if the diff of each failed test case should include
+	// the unified diff of the expected and the actual output.
+	IncludeDiff bool `json:"include_diff"`
+
+	// Comparator is the optional comparator used to compare the output of
+	// every test case, if not set the output must match exactly.
+	Comparator *sandbox.Comparator `json:"comparator"`
+
+	// Checker is the optional checker program judging the output of every
+	// test case instead of the comparator.
+	Checker *CompileChecker `json:"checker"`
+
+	// Interactor is the optional interactor program running alongside the
+	// code for every test case, deciding the verdict of each.
+	Interactor *CompileInteractor `json:"interactor"`
+
+	// TestGroups are the optional groups the test cases are scored in, every
+	// test case belongs to a single group.
+	TestGroups []*sandbox.TestGroup `json:"test_groups"`
+}
+
+// CompileChecker is the checker program of a compile message, the source of
+// the checker is stored alongside the files of the execution.
+type CompileChecker struct {
+	Language string `json:"language"`
+}
+
+// CompileInteractor is the interactor program of a compile message, the source
+// of the interactor is stored alongside the files of the execution.
+type CompileInteractor struct {
+	Language string `json:"language"`
+}
+
+// Limits returns the sandbox limits requested by the compile message.
+func (c *CompileMessage) Limits() *sandbox.Limits {
+	return &sandbox.Limits{
+		CodeTimeout:     time.Duration(c.TimeLimitMs) * time.Millisecond,
+		CompileTimeout:  time.Duration(c.CompileTimeLimitMs) * time.Millisecond,
+		ExecutionMemory: memory.Memory(c.MemoryLimitMb) * memory.Megabyte,
+	}
+}
+
+type CompileTestCase struct {
 	StdinData          []string `json:"stdin_data"`
 	ExpectedStdoutData []string `json:"expected_stdout_data"`
 }
 
+// cancellationPollInterval is the interval in which the repository is checked
+// to determine if a running execution has been cancelled by the consumer.
+const cancellationPollInterval = time.Millisecond * 500
+
 type NsqConfig struct {
 	Topic            string
 	Channel          string
@@ -38,6 +115,10 @@ type NsqConfig struct {
 	Repo repository.Repository
 
 	FilesHandler files.Files
+
+	// Webhooks is used to deliver the completion webhooks of executions which
+	// provided a callback url.
+	Webhooks *webhook.Sender
 }
 
 type SqsConfig struct {
@@ -54,6 +135,10 @@ type SqsConfig struct {
 	Repo repository.Repository
 
 	FilesHandler files.Files
+
+	// Webhooks is used to deliver the completion webhooks of executions which
+	// provided a callback url.
+	Webhooks *webhook.Sender
 }
 
 type Config struct {
@@ -69,9 +154,73 @@ type Config struct {
 	ForceLocalMode bool
 }
 
+// SubmitError is returned when only some of the messages could be submitted
+// to the queue, any other error means none of the messages were submitted.
+type SubmitError struct {
+	// The indexes of the messages which failed to be submitted.
+	Failed []int
+	Err    error
+}
+
+func (e *SubmitError) Error() string {
+	return fmt.Sprintf("failed to submit %d messages to the queue: %s", len(e.Failed), e.Err)
+}
+
+func (e *SubmitError) Unwrap() error {
+	return e.Err
+}
+
+// submitResult returns the error of submitting the messages given the indexes
+// of the messages which failed, a SubmitError if only some of them failed.
+func submitResult(failed []int, total int, err error) error {
+	if len(failed) == 0 {
+		return nil
+	}
+
+	if len(failed) == total {
+		return err
+	}
+
+	sort.Ints(failed)
+
+	return &SubmitError{Failed: failed, Err: err}
+}
+
+// submitInChunks publishes the messages in chunks of the given size. A chunk
+// which fails is retried one message at a time, so only the messages which
+// could not be published are reported as failed.
+func submitInChunks(data [][]byte, size int, publishChunk func([][]byte) error, publish func([]byte) error) error {
+	var failed []int
+	var lastErr error
+
+	for start := 0; start < len(data); start += size {
+		end := min(start+size, len(data))
+
+		if err := publishChunk(data[start:end]); err == nil {
+			continue
+		}
+
+		for i := start; i < end; i++ {
+			if err := publish(data[i]); err != nil {
+				failed = append(failed, i)
+				lastErr = err
+			}
+		}
+	}
+
+	return submitResult(failed, len(data), lastErr)
+}
+
 type Queue interface {
 	HandleIncomingRequest(data []byte) error
 	SubmitMessageToQueue(data []byte) error
+
+	// SubmitMessagesToQueue submits all the messages to the queue, returning a
+	// SubmitError if only some of the messages were submitted.
+	SubmitMessagesToQueue(data [][]byte) error
+
+	// Ping ensures the queue is reachable by the producer and the consumer.
+	Ping() error
 	Stop()
 }
 
@@ -83,7 +232,8 @@ func NewQueue(config *Config) (Queue, error) {
 	return newSqsQueue(config.Sqs)
 }
 
-func handleNewCompileRequest(data []byte, manager *sandbox.ContainerManager, repo repository.Repository, fileHandler files.Files) error {
+func handleNewCompileRequest(data []byte, manager *sandbox.ContainerManager, repo repository.Repository,
+	fileHandler files.Files, webhooks *webhook.Sender) error {
 	var compileMsg CompileMessage
 
 	if err := json.Unmarshal(data, &compileMsg); err != nil {
@@ -95,34 +245,86 @@ func handleNewCompileRequest(data []byte, manager *sandbox.ContainerManager, rep
 		Str("language", compileMsg.Language).
 		Msg("handling new compile request")
 
+	// the execution could have been cancelled while it was still waiting in the
+	// queue, if so there is no need to start the container at all.
+	if execution, err := repo.GetExecution(compileMsg.ID); err == nil && execution.CancelledAt != nil {
+		log.Info().Str("id", compileMsg.ID).Msg("skipping cancelled compile request")
+		sendStatusWebhook(webhooks, &compileMsg, sandbox.Cancelled)
+		return nil
+	}
+
 	compiler := sandbox.Compilers[compileMsg.Language]
 
-	sourceCode, _ := fileHandler.GetFile(compileMsg.ID, compiler.SourceFile)
+	var sourceCode []byte
+
+	if len(compileMsg.Files) == 0 {
+		sourceCode, _ = fileHandler.GetFile(compileMsg.ID, compiler.SourceFile)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 	defer cancel()
 
 	sandboxRequest := sandbox.Request{
-		ID:               compileMsg.ID,
-		ExecutionProfile: sandbox.GetProfileForMachine(),
-		Path:             filepath.Join(os.TempDir(), "executions", "raw", compileMsg.ID),
-		SourceCode:       string(sourceCode),
-		Compiler:         compiler,
-		Test:             nil,
-	}
-
-	if len(compileMsg.StdinData) > 0 || len(compileMsg.ExpectedStdoutData) > 0 {
-		sandboxRequest.Test = &sandbox.Test{
-			ID:                 compileMsg.ID,
-			StdinData:          compileMsg.StdinData,
-			ExpectedStdoutData: compileMsg.ExpectedStdoutData,
+		ID:                 compileMsg.ID,
+		ExecutionProfile:   sandbox.GetProfileForMachine().WithLimits(compileMsg.Limits()),
+		Path:               filepath.Join(os.TempDir(), "executions", "raw", compileMsg.ID),
+		SourceCode:         string(sourceCode),
+		EntryFile:          compileMsg.EntryFile,
+		IncludeBinary:      compileMsg.IncludeBinary,
+		IncludeUnifiedDiff: compileMsg.IncludeDiff,
+		Compiler:           compiler,
+		Tests:              make([]*sandbox.Test, 0, len(compileMsg.TestCases)),
+	}
+
+	filesID := compileMsg.ID
+
+	if compileMsg.SourceID != "" {
+		filesID = compileMsg.SourceID
+	}
+
+	for _, path := range compileMsg.Files {
+		content, err := fileHandler.GetFile(filesID, sandbox.ProjectFileName(path))
+
+		if err != nil {
+			failExecution(repo, webhooks, &compileMsg)
+			return errors.Wrapf(err, "failed to get project file %s", path)
 		}
+
+		sandboxRequest.Files = append(sandboxRequest.Files, &sandbox.SourceFile{
+			Path:    path,
+			Content: string(content),
+		})
+	}
+
+	if compileMsg.Interactor != nil {
+		interactorCompiler := sandbox.Compilers[compileMsg.Interactor.Language]
+		interactorSource, err := fileHandler.GetFile(compileMsg.ID, sandbox.InteractorFileName(interactorCompiler.SourceFile))
+
+		if err != nil {
+			failExecution(repo, webhooks, &compileMsg)
+			return errors.Wrap(err, "failed to get interactor source")
+		}
+
+		sandboxRequest.Interactor = &sandbox.Interactor{
+			Compiler:   interactorCompiler,
+			SourceCode: string(interactorSource),
+		}
+	}
+
+	for i, testCase := range compileMsg.TestCases {
+		sandboxRequest.Tests = append(sandboxRequest.Tests, &sandbox.Test{
+			ID:                 fmt.Sprintf("%s-%d", compileMsg.ID, i),
+			StdinData:          testCase.StdinData,
+			ExpectedStdoutData: testCase.ExpectedStdoutData,
+			Comparator:         compileMsg.Comparator,
+		})
 	}
 
 	_ = repo.UpdateExecutionStatus(compileMsg.ID, sandbox.Created.String())
 	containerID, complete, err := manager.AddContainer(ctx, &sandboxRequest)
 
 	if err != nil {
-		_ = repo.UpdateExecutionStatus(compileMsg.ID, sandbox.NonDeterministicError.String())
+		failExecution(repo, webhooks, &compileMsg)
 		return errors.Wrap(err, "failed to add container to Manager")
 	}
 
@@ -132,22 +334,69 @@ func handleNewCompileRequest(data []byte, manager *sandbox.ContainerManager, rep
 	// has run too long. It's probably something that should happen inside the
 	// manager to close this after failure but for now lets just timeout after
 	// a selective amount of time.
-	maxTimeout := sandboxRequest.ExecutionProfile.CodeTimeout +
+	runs := len(sandboxRequest.Tests)
+
+	if runs == 0 {
+		runs = 1
+	}
+
+	maxTimeout := sandboxRequest.ExecutionProfile.CodeTimeout*time.Duration(runs) +
 		sandboxRequest.ExecutionProfile.CompileTimeout
 
+	// the interactor is compiled after the code, bound by its own timeout.
+	if sandboxRequest.Interactor != nil {
+		maxTimeout += sandboxRequest.ExecutionProfile.CompileTimeout
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	cancelled := watchForCancellation(done, repo, compileMsg.ID)
+
 	select {
 	case <-complete:
+	case <-cancelled:
+		log.Info().
+			Str("id", containerID).
+			Msg("killing cancelled container execution")
+
+		sendStatusWebhook(webhooks, &compileMsg, sandbox.Cancelled)
+
+		if err := manager.RemoveContainer(context.Background(), containerID, true); err != nil {
+			return errors.Wrap(err, "failed to kill cancelled container")
+		}
+
+		return nil
 	case <-time.After(maxTimeout):
 		log.Error().
 			Str("id", containerID).
 			Dur("duration", maxTimeout).
 			Msg("entire container execution timeout")
 
+		_ = repo.UpdateExecutionStatus(compileMsg.ID, sandbox.TimeLimitExceeded.String())
+		sendStatusWebhook(webhooks, &compileMsg, sandbox.TimeLimitExceeded)
+
+		if err := manager.RemoveContainer(context.Background(), containerID, true); err != nil {
+			return errors.Wrap(err, "failed to kill timed out container")
+		}
+
 		return nil
 	}
 
 	resp := manager.GetResponse(ctx, containerID)
 
+	// the container is removed before running the checker, since the checker
+	// requires a container of its own.
+	_ = manager.RemoveContainer(context.Background(), containerID, false)
+
+	if compileMsg.Checker != nil {
+		runChecker(manager, fileHandler, &compileMsg, &sandboxRequest, resp)
+	}
+
+	// the groups are scored once the checker has given the final score of
+	// each test case.
+	sandbox.ScoreTestGroups(resp, compileMsg.TestGroups)
+
 	uploadFiles := []*files.File{{
 		ID:   sandboxRequest.ID,
 		Name: compiler.OutputFile,
@@ -166,9 +415,29 @@ func handleNewCompileRequest(data []byte, manager *sandbox.ContainerManager, rep
 		})
 	}
 
-	_ = fileHandler.WriteFiles(uploadFiles...)
+	artifacts := make([]*repository.Artifact, 0, len(resp.Artifacts))
 
-	_ = manager.RemoveContainer(context.Background(), containerID, false)
+	for _, artifact := range resp.Artifacts {
+		uploadFiles = append(uploadFiles, &files.File{
+			ID:   sandboxRequest.ID,
+			Name: sandbox.ArtifactFileName(artifact.Path),
+			Data: artifact.Data,
+		})
+
+		artifacts = append(artifacts, &repository.Artifact{
+			ExecutionID: compileMsg.ID,
+			Path:        artifact.Path,
+			SizeBytes:   int64(len(artifact.Data)),
+		})
+	}
+
+	if errs := fileHandler.WriteFiles(uploadFiles...); len(errs) > 0 {
+		log.Error().Errs("errors", errs).Str("id", compileMsg.ID).Msg("failed to write execution files")
+	}
+
+	if err := repo.InsertArtifacts(artifacts); err != nil {
+		log.Error().Err(err).Str("id", compileMsg.ID).Msg("failed to insert execution artifacts")
+	}
 
 	_, _ = repo.UpdateExecution(compileMsg.ID, &repository.Execution{
 		Status:          resp.Status.String(),
@@ -176,7 +445,186 @@ func handleNewCompileRequest(data []byte, manager *sandbox.ContainerManager, rep
 		CompileMs:       resp.CompileTime.Milliseconds(),
 		RuntimeMs:       resp.Runtime.Milliseconds(),
 		RuntimeMemoryMb: resp.RuntimeMemory.Megabytes(),
+		Score:           resp.Score,
+		MaxScore:        resp.MaxScore,
 	})
 
+	testCases := make([]*repository.ExecutionTestCase, 0, len(resp.TestCases))
+
+	for i, testCase := range resp.TestCases {
+		testCases = append(testCases, &repository.ExecutionTestCase{
+			ExecutionID:     compileMsg.ID,
+			Index:           i,
+			Status:          testCase.Status.String(),
+			TestStatus:      testCase.TestStatus.String(),
+			RuntimeMs:       testCase.Runtime.Milliseconds(),
+			RuntimeMemoryMb: testCase.RuntimeMemory.Megabytes(),
+			Score:           testCase.Score,
+			CheckerMessage:  testCase.CheckerMessage,
+
+			InteractorRuntimeMs:       testCase.InteractorRuntime.Milliseconds(),
+			InteractorRuntimeMemoryMb: testCase.InteractorRuntimeMemory.Megabytes(),
+
+			Diff: newExecutionTestCaseDiff(testCase.Diff),
+		})
+	}
+
+	if err := repo.InsertExecutionTestCases(testCases); err != nil {
+		log.Error().Err(err).Str("id", compileMsg.ID).Msg("failed to insert execution test cases")
+	}
+
+	testGroups := make([]*repository.ExecutionTestGroup, 0, len(resp.Groups))
+
+	for i, group := range resp.Groups {
+		testGroups = append(testGroups, &repository.ExecutionTestGroup{
+			ExecutionID: compileMsg.ID,
+			Index:       i,
+			Name:        group.Name,
+			TestStatus:  group.TestStatus.String(),
+			Score:       group.Score,
+			Weight:      group.Weight,
+		})
+	}
+
+	if err := repo.InsertExecutionTestGroups(testGroups); err != nil {
+		log.Error().Err(err).Str("id", compileMsg.ID).Msg("failed to insert execution test groups")
+	}
+
+	if compileMsg.CallbackURL != "" && webhooks != nil {
+		// delivering is retried with a backoff, so it's done outside the
+		// request to avoid holding up the queue while the receiver is down.
+		sendCompletionWebhook(webhooks, &compileMsg, resp)
+	}
+
 	return nil
 }
+
+// newExecutionTestCaseDiff returns the stored diff of a failed test case.
+func newExecutionTestCaseDiff(diff *sandbox.OutputDiff) *repository.ExecutionTestCaseDiff {
+	if diff == nil {
+		return nil
+	}
+
+	return &repository.ExecutionTestCaseDiff{
+		Line:          diff.Line,
+		Column:        diff.Column,
+		Token:         diff.Token,
+		Expected:      diff.Expected,
+		Actual:        diff.Actual,
+		ExpectedLines: diff.ExpectedLines,
+		ActualLines:   diff.ActualLines,
+		UnifiedDiff:   diff.UnifiedDiff,
+	}
+}
+
+// newWebhookTestCaseDiff returns the diff of a failed test case delivered
+// within the completion webhook.
+func newWebhookTestCaseDiff(diff *sandbox.OutputDiff) *webhook.TestCaseDiff {
+	if diff == nil {
+		return nil
+	}
+
+	return &webhook.TestCaseDiff{
+		Line:          diff.Line,
+		Column:        diff.Column,
+		Token:         diff.Token,
+		Expected:      diff.Expected,
+		Actual:        diff.Actual,
+		ExpectedLines: diff.ExpectedLines,
+		ActualLines:   diff.ActualLines,
+		UnifiedDiff:   diff.UnifiedDiff,
+	}
+}
+
+// sendCompletionWebhook delivers the final result of the execution to the
+// callback url of the compile message in the background.
+func sendCompletionWebhook(webhooks *webhook.Sender, compileMsg *CompileMessage, resp *sandbox.Response) {
+	payload := &webhook.Payload{
+		ID:              compileMsg.ID,
+		Language:        compileMsg.Language,
+		Status:          resp.Status.String(),
+		TestStatus:      resp.TestStatus.String(),
+		CompileMs:       resp.CompileTime.Milliseconds(),
+		RuntimeMs:       resp.Runtime.Milliseconds(),
+		RuntimeMemoryMb: resp.RuntimeMemory.Megabytes(),
+		TestCases:       make([]webhook.TestCase, 0, len(resp.TestCases)),
+		Score:           resp.Score,
+		MaxScore:        resp.MaxScore,
+	}
+
+	for _, group := range resp.Groups {
+		payload.TestGroups = append(payload.TestGroups, webhook.TestGroup{
+			Name:       group.Name,
+			TestStatus: group.TestStatus.String(),
+			Score:      group.Score,
+			Weight:     group.Weight,
+		})
+	}
+
+	for _, testCase := range resp.TestCases {
+		payload.TestCases = append(payload.TestCases, webhook.TestCase{
+			Status:          testCase.Status.String(),
+			TestStatus:      testCase.TestStatus.String(),
+			RuntimeMs:       testCase.Runtime.Milliseconds(),
+			RuntimeMemoryMb: testCase.RuntimeMemory.Megabytes(),
+			Score:           testCase.Score,
+			CheckerMessage:  testCase.CheckerMessage,
+
+			InteractorRuntimeMs:       testCase.InteractorRuntime.Milliseconds(),
+			InteractorRuntimeMemoryMb: testCase.InteractorRuntimeMemory.Megabytes(),
+
+			Diff: newWebhookTestCaseDiff(testCase.Diff),
+		})
+	}
+
+	webhooks.SendAsync(compileMsg.CallbackURL, payload)
+}
+
+// failExecution marks the execution as failed when it could not be executed,
+// delivering the final status to the callback url.
+func failExecution(repo repository.Repository, webhooks *webhook.Sender, compileMsg *CompileMessage) {
+	_ = repo.UpdateExecutionStatus(compileMsg.ID, sandbox.NonDeterministicError.String())
+	sendStatusWebhook(webhooks, compileMsg, sandbox.NonDeterministicError)
+}
+
+// sendStatusWebhook delivers the final status of an execution which ended
+// without a result, e.g. it was cancelled or timed out, to the callback url
+// of the compile message in the background.
+func sendStatusWebhook(webhooks *webhook.Sender, compileMsg *CompileMessage, status sandbox.ContainerStatus) {
+	if compileMsg.CallbackURL == "" || webhooks == nil {
+		return
+	}
+
+	webhooks.SendAsync(compileMsg.CallbackURL, &webhook.Payload{
+		ID:         compileMsg.ID,
+		Language:   compileMsg.Language,
+		Status:     status.String(),
+		TestStatus: sandbox.TestNotRan.String(),
+		TestCases:  []webhook.TestCase{},
+	})
+}
+
+// watchForCancellation polls the repository until the execution has been
+// cancelled, closing the returned channel, or until done has been closed.
+func watchForCancellation(done <-chan struct{}, repo repository.Repository, id string) <-chan struct{} {
+	cancelled := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(cancellationPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if execution, err := repo.GetExecution(id); err == nil && execution.CancelledAt != nil {
+					close(cancelled)
+					return
+				}
+			}
+		}
+	}()
+
+	return cancelled
+}
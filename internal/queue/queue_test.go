@@ -0,0 +1,82 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmitInChunks(t *testing.T) {
+	errPublish := errors.New("publish failed")
+
+	tests := []struct {
+		name    string
+		total   int
+		failing map[string]bool
+		chunks  int
+		wantErr error
+	}{{
+		name:   "should publish every chunk",
+		total:  5,
+		chunks: 3,
+	}, {
+		name:    "should only fail the messages which could not be published",
+		total:   5,
+		failing: map[string]bool{"1": true, "4": true},
+		chunks:  3,
+		wantErr: &SubmitError{Failed: []int{1, 4}, Err: errPublish},
+	}, {
+		name:    "should return the error when every message failed",
+		total:   2,
+		failing: map[string]bool{"0": true, "1": true},
+		chunks:  1,
+		wantErr: errPublish,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := make([][]byte, 0, tt.total)
+
+			for i := 0; i < tt.total; i++ {
+				data = append(data, []byte{byte('0' + i)})
+			}
+
+			chunks := 0
+			published := map[string]bool{}
+
+			publish := func(message []byte) error {
+				if tt.failing[string(message)] {
+					return errPublish
+				}
+
+				published[string(message)] = true
+				return nil
+			}
+
+			// a chunk is published all together or not at all, the same as a
+			// multi publish.
+			publishChunk := func(chunk [][]byte) error {
+				chunks++
+
+				for _, message := range chunk {
+					if tt.failing[string(message)] {
+						return errPublish
+					}
+				}
+
+				for _, message := range chunk {
+					published[string(message)] = true
+				}
+
+				return nil
+			}
+
+			err := submitInChunks(data, 2, publishChunk, publish)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.chunks, chunks)
+			assert.Len(t, published, tt.total-len(tt.failing))
+		})
+	}
+}
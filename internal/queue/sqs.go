@@ -1,6 +1,8 @@
 package queue
 
 import (
+	"fmt"
+	"strconv"
 	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -10,6 +12,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 )
 
+// sqsMaxBatchEntries is the maximum number of messages SQS accepts within a
+// single send message batch request.
+const sqsMaxBatchEntries = 10
+
 type SqsQueue struct {
 	config *SqsConfig
 
@@ -98,7 +104,7 @@ func (s SqsQueue) startPollingMessages() {
 }
 
 func (s SqsQueue) HandleIncomingRequest(data []byte) error {
-	return handleNewCompileRequest(data, s.config.Manager, s.config.Repo, s.config.FilesHandler)
+	return handleNewCompileRequest(data, s.config.Manager, s.config.Repo, s.config.FilesHandler, s.config.Webhooks)
 }
 
 func (s SqsQueue) SubmitMessageToQueue(data []byte) error {
@@ -110,6 +116,64 @@ func (s SqsQueue) SubmitMessageToQueue(data []byte) error {
 	return err
 }
 
+// SubmitMessagesToQueue sends the messages in batches of the maximum number
+// of entries SQS allows within a single request.
+func (s SqsQueue) SubmitMessagesToQueue(data [][]byte) error {
+	var failed []int
+	var lastErr error
+
+	for start := 0; start < len(data); start += sqsMaxBatchEntries {
+		end := start + sqsMaxBatchEntries
+
+		if end > len(data) {
+			end = len(data)
+		}
+
+		entries := make([]*sqs.SendMessageBatchRequestEntry, 0, end-start)
+
+		for i := start; i < end; i++ {
+			entries = append(entries, &sqs.SendMessageBatchRequestEntry{
+				Id:          aws.String(strconv.Itoa(i)),
+				MessageBody: aws.String(string(data[i])),
+			})
+		}
+
+		output, err := s.sqsQueue.SendMessageBatch(&sqs.SendMessageBatchInput{
+			Entries:  entries,
+			QueueUrl: aws.String(s.config.QueueURL),
+		})
+
+		// the remaining chunks are still sent, so a single failing request
+		// only fails the messages of its own chunk.
+		if err != nil {
+			for i := start; i < end; i++ {
+				failed = append(failed, i)
+			}
+
+			lastErr = err
+			continue
+		}
+
+		for _, entry := range output.Failed {
+			index, _ := strconv.Atoi(aws.StringValue(entry.Id))
+			failed = append(failed, index)
+
+			lastErr = fmt.Errorf("%s: %s", aws.StringValue(entry.Code), aws.StringValue(entry.Message))
+		}
+	}
+
+	return submitResult(failed, len(data), lastErr)
+}
+
+func (s SqsQueue) Ping() error {
+	_, err := s.sqsQueue.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(s.config.QueueURL),
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameQueueArn}),
+	})
+
+	return err
+}
+
 func (s *SqsQueue) setStopFlag() {
 	s.stopFlag = true
 }
@@ -0,0 +1,214 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/gorm"
+
+	"compile-and-run-sandbox/internal/auth"
+	"compile-and-run-sandbox/internal/repository"
+)
+
+// RetryAfterHeader is the metadata key containing the number of seconds the
+// caller should wait before retrying a request that exceeded a limit.
+const RetryAfterHeader = "retry-after"
+
+// limitsRefreshInterval is the interval in which the limits of a tenant are
+// reloaded from the repository, allowing limits to change without a restart.
+const limitsRefreshInterval = time.Minute
+
+type Limits struct {
+	// The number of requests per second allowed, with a burst of up to
+	// RequestBurst requests.
+	RequestsPerSecond float64
+	RequestBurst      int
+
+	// The maximum number of executions that can be queued or running at once.
+	MaxInFlightExecutions int64
+}
+
+type tenantLimiter struct {
+	limits   *Limits
+	limiter  *rate.Limiter
+	loadedAt time.Time
+}
+
+// Limiter enforces a token bucket rate limit per tenant along with providing
+// the limits of each tenant. Tenants without limits in the repository use the
+// default limits.
+type Limiter struct {
+	repo     repository.Repository
+	defaults *Limits
+
+	mu      sync.Mutex
+	tenants map[string]*tenantLimiter
+}
+
+func NewLimiter(repo repository.Repository, defaults *Limits) *Limiter {
+	return &Limiter{
+		repo:     repo,
+		defaults: defaults,
+		tenants:  map[string]*tenantLimiter{},
+	}
+}
+
+// Limits returns the limits of the tenant.
+func (l *Limiter) Limits(tenantID string) *Limits {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.getTenantLimiter(tenantID).limits
+}
+
+// Allow returns true if the tenant can make another request, otherwise false
+// and the duration until the next request would be allowed.
+func (l *Limiter) Allow(tenantID string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	reservation := l.getTenantLimiter(tenantID).limiter.Reserve()
+
+	if !reservation.OK() {
+		return false, time.Second
+	}
+
+	if delay := reservation.Delay(); delay > 0 {
+		reservation.Cancel()
+		return false, delay
+	}
+
+	return true, 0
+}
+
+// getTenantLimiter returns the limiter of the tenant, loading or refreshing
+// the limits from the repository when required. Must be called while holding
+// the lock.
+func (l *Limiter) getTenantLimiter(tenantID string) *tenantLimiter {
+	tenant, ok := l.tenants[tenantID]
+
+	if ok && time.Since(tenant.loadedAt) < limitsRefreshInterval {
+		return tenant
+	}
+
+	limits := l.loadLimits(tenantID)
+
+	if !ok {
+		tenant = &tenantLimiter{limiter: rate.NewLimiter(rate.Limit(limits.RequestsPerSecond), limits.RequestBurst)}
+		l.tenants[tenantID] = tenant
+	} else {
+		tenant.limiter.SetLimit(rate.Limit(limits.RequestsPerSecond))
+		tenant.limiter.SetBurst(limits.RequestBurst)
+	}
+
+	tenant.limits = limits
+	tenant.loadedAt = time.Now()
+
+	return tenant
+}
+
+// loadLimits returns the limits of the tenant from the repository, falling
+// back to the defaults for every limit that has not been defined.
+func (l *Limiter) loadLimits(tenantID string) *Limits {
+	limits := *l.defaults
+
+	tenantLimit, err := l.repo.GetTenantLimit(tenantID)
+
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Str("tenant", tenantID).Msg("failed to load tenant limits")
+		}
+
+		return &limits
+	}
+
+	if tenantLimit.RequestsPerSecond > 0 {
+		limits.RequestsPerSecond = tenantLimit.RequestsPerSecond
+	}
+
+	if tenantLimit.RequestBurst > 0 {
+		limits.RequestBurst = tenantLimit.RequestBurst
+	}
+
+	if tenantLimit.MaxInFlightExecutions > 0 {
+		limits.MaxInFlightExecutions = tenantLimit.MaxInFlightExecutions
+	}
+
+	return &limits
+}
+
+// UnaryServerInterceptor returns a unary interceptor rejecting requests of
+// tenants that exceeded their rate limit. Must be placed after the
+// authentication interceptor, unauthenticated requests are not limited.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.limit(ctx); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a stream interceptor rejecting streams of
+// tenants that exceeded their rate limit.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.limit(stream.Context()); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+func (l *Limiter) limit(ctx context.Context) error {
+	tenantID := auth.TenantID(ctx)
+
+	if tenantID == "" {
+		return nil
+	}
+
+	if allowed, retryAfter := l.Allow(tenantID); !allowed {
+		return ResourceExhausted(ctx, "rate limit exceeded", retryAfter)
+	}
+
+	return nil
+}
+
+// ResourceExhausted returns a resource exhausted status error, informing the
+// caller when to retry through the retry-after header and the retry info
+// error details.
+func ResourceExhausted(ctx context.Context, message string, retryAfter time.Duration) error {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.FormatInt(seconds, 10))); err != nil {
+		log.Debug().Err(err).Msg("failed to set retry after header")
+	}
+
+	st, err := status.New(codes.ResourceExhausted, message).WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(time.Duration(seconds) * time.Second),
+	})
+
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, message)
+	}
+
+	return st.Err()
+}
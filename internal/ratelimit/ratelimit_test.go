@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"compile-and-run-sandbox/internal/repository"
+)
+
+type limitRepository struct {
+	repository.Repository
+	limits map[string]repository.TenantLimit
+}
+
+func (l limitRepository) GetTenantLimit(tenantID string) (repository.TenantLimit, error) {
+	if limit, ok := l.limits[tenantID]; ok {
+		return limit, nil
+	}
+
+	return repository.TenantLimit{}, gorm.ErrRecordNotFound
+}
+
+func newTestLimiter() *Limiter {
+	return NewLimiter(limitRepository{limits: map[string]repository.TenantLimit{
+		"custom": {TenantID: "custom", RequestBurst: 3, MaxInFlightExecutions: 100},
+	}}, &Limits{
+		RequestsPerSecond:     1,
+		RequestBurst:          1,
+		MaxInFlightExecutions: 10,
+	})
+}
+
+func TestLimiterLimits(t *testing.T) {
+	limiter := newTestLimiter()
+
+	assert.Equal(t, &Limits{RequestsPerSecond: 1, RequestBurst: 1, MaxInFlightExecutions: 10}, limiter.Limits("default"))
+	assert.Equal(t, &Limits{RequestsPerSecond: 1, RequestBurst: 3, MaxInFlightExecutions: 100}, limiter.Limits("custom"))
+}
+
+func TestLimiterAllow(t *testing.T) {
+	limiter := newTestLimiter()
+
+	allowed, _ := limiter.Allow("default")
+	assert.True(t, allowed)
+
+	allowed, retryAfter := limiter.Allow("default")
+	assert.False(t, allowed)
+	assert.Greater(t, retryAfter, time.Duration(0))
+
+	// tenants have their own bucket, the custom tenant has a larger burst.
+	for i := 0; i < 3; i++ {
+		allowed, _ = limiter.Allow("custom")
+		assert.True(t, allowed)
+	}
+
+	allowed, _ = limiter.Allow("custom")
+	assert.False(t, allowed)
+}
+
+func TestResourceExhausted(t *testing.T) {
+	err := ResourceExhausted(context.Background(), "rate limit exceeded", time.Millisecond*1500)
+	st := status.Convert(err)
+
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Len(t, st.Details(), 1)
+
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	assert.True(t, ok)
+	assert.Equal(t, time.Second*2, retryInfo.RetryDelay.AsDuration())
+}
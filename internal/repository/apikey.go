@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"time"
+)
+
+type APIKey struct {
+	// Hash is the SHA-256 hash of the key, the key itself is never stored and
+	// only given to the caller once when created.
+	Hash string `gorm:"primarykey"`
+
+	// TenantID is the tenant the caller is authenticated as when using the key.
+	TenantID string `gorm:"index"`
+	Name     string
+
+	// RevokedAt is set once the key has been revoked and can no longer be used.
+	RevokedAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (c Client) InsertAPIKey(key *APIKey) error {
+	result := c.DB.Create(key)
+	return result.Error
+}
+
+// GetAPIKey returns the api key with the given hash as long as it has not
+// been revoked.
+func (c Client) GetAPIKey(hash string) (APIKey, error) {
+	key := APIKey{}
+
+	result := c.DB.Where("hash = ? AND revoked_at IS NULL", hash).First(&key)
+	return key, result.Error
+}
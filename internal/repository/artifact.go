@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"time"
+)
+
+// Artifact is a file written by the code into the artifacts directory, the
+// content is stored alongside the files of the execution.
+type Artifact struct {
+	ExecutionID string `gorm:"primarykey"`
+	Path        string `gorm:"primarykey"`
+	SizeBytes   int64
+
+	CreatedAt time.Time
+}
+
+func (c Client) InsertArtifacts(artifacts []*Artifact) error {
+	if len(artifacts) == 0 {
+		return nil
+	}
+
+	result := c.DB.Create(artifacts)
+	return result.Error
+}
+
+// GetArtifacts returns the artifacts of the execution ordered by their path.
+func (c Client) GetArtifacts(executionID string) ([]Artifact, error) {
+	var artifacts []Artifact
+
+	result := c.DB.Where("execution_id = ?", executionID).Order("path").Find(&artifacts)
+	return artifacts, result.Error
+}
+
+func (c Client) GetArtifact(executionID string, path string) (Artifact, error) {
+	artifact := Artifact{}
+
+	result := c.DB.Where("execution_id = ? AND path = ?", executionID, path).First(&artifact)
+	return artifact, result.Error
+}
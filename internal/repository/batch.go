@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Batch struct {
+	ID string `gorm:"primarykey"`
+
+	// TenantID is the tenant that submitted the batch.
+	TenantID string `gorm:"index"`
+
+	// The total number of executions submitted as part of the batch.
+	Total int64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// StatusCount is the number of executions with the given status and test
+// status.
+type StatusCount struct {
+	Status     string
+	TestStatus string
+	Count      int64
+}
+
+// InsertBatch inserts the batch and all of its executions within a single
+// transaction, the executions are inserted in chunks. Nothing is inserted if
+// the executions would exceed the in-flight limit, if given, returning
+// ErrInFlightLimitReached instead.
+func (c Client) InsertBatch(batch *Batch, executions []*Execution, limit *InFlightLimit) error {
+	return c.DB.Transaction(func(tx *gorm.DB) error {
+		if err := reserveInFlight(tx, limit, int64(len(executions))); err != nil {
+			return err
+		}
+
+		if err := tx.Create(batch).Error; err != nil {
+			return err
+		}
+
+		for _, execution := range executions {
+			execution.BatchID = &batch.ID
+		}
+
+		return tx.CreateInBatches(executions, 100).Error
+	})
+}
+
+func (c Client) GetBatch(id string) (Batch, error) {
+	batch := Batch{}
+
+	result := c.DB.Where("id = ?", id).First(&batch)
+	return batch, result.Error
+}
+
+// GetBatchStatusCounts returns the number of executions of the batch grouped
+// by their status and test status.
+func (c Client) GetBatchStatusCounts(batchID string) ([]StatusCount, error) {
+	var counts []StatusCount
+
+	result := c.DB.Model(&Execution{}).
+		Select("status, test_status, count(*) as count").
+		Where("batch_id = ?", batchID).
+		Group("status, test_status").
+		Scan(&counts)
+
+	return counts, result.Error
+}
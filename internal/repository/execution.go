@@ -2,10 +2,16 @@ package repository
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Execution struct {
-	ID string `gorm:"primarykey"`
+	ID string `gorm:"primarykey;index:idx_executions_created_at_id,priority:2"`
+
+	// TenantID is the tenant that created the execution, only the same tenant
+	// can read the execution.
+	TenantID string `gorm:"index"`
 
 	Language   string
 	Status     string
@@ -15,17 +21,60 @@ type Execution struct {
 	RuntimeMs       int64
 	RuntimeMemoryMb float64
 
-	CreatedAt time.Time
+	// Score is the score of the groups of tests out of the MaxScore, only
+	// scored if the execution provided groups of tests.
+	Score    float64
+	MaxScore float64
+
+	// BatchID is the batch the execution was submitted with, if any.
+	BatchID *string `gorm:"index"`
+
+	// CancelledAt is set once the consumer has requested the execution to be
+	// cancelled, any later status updates from the loader are ignored.
+	CancelledAt *time.Time
+
+	CreatedAt time.Time `gorm:"index:idx_executions_created_at_id,priority:1"`
 	UpdatedAt time.Time
 }
 
-func (c Client) InsertExecution(execution *Execution) error {
-	result := c.DB.Create(execution)
-	return result.Error
+// ExecutionCursor is the position of an execution within the list ordering,
+// used to continue listing executions from after the given execution.
+type ExecutionCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+// ExecutionFilter is used to filter the executions when listing them, each
+// field is only applied if it is set.
+type ExecutionFilter struct {
+	TenantID   string
+	Language   string
+	Status     string
+	TestStatus string
+
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+
+	// After is the cursor of the last execution of the previous page, only
+	// executions ordered after this execution will be returned.
+	After *ExecutionCursor
+	Limit int
+}
+
+// InsertExecution inserts the execution as long as it does not exceed the
+// in-flight limit, if given, returning ErrInFlightLimitReached otherwise.
+func (c Client) InsertExecution(execution *Execution, limit *InFlightLimit) error {
+	return c.DB.Transaction(func(tx *gorm.DB) error {
+		if err := reserveInFlight(tx, limit, 1); err != nil {
+			return err
+		}
+
+		return tx.Create(execution).Error
+	})
 }
 
 func (c Client) UpdateExecution(id string, columns *Execution) (bool, error) {
-	result := c.DB.Model(&Execution{ID: id}).Where("").Updates(columns)
+	result := c.DB.Model(&Execution{ID: id}).Where("cancelled_at IS NULL").Updates(columns)
 	return result.RowsAffected > 0, result.Error
 }
 
@@ -37,6 +86,66 @@ func (c Client) GetExecution(id string) (Execution, error) {
 }
 
 func (c Client) UpdateExecutionStatus(id string, status string) error {
-	result := c.DB.Where("id = ?", id).UpdateColumns(Execution{Status: status})
+	result := c.DB.Where("id = ? AND cancelled_at IS NULL", id).UpdateColumns(Execution{Status: status})
 	return result.Error
 }
+
+// UpdateExecutionsStatus updates the status of all the given executions which
+// have not been cancelled.
+func (c Client) UpdateExecutionsStatus(ids []string, status string) error {
+	result := c.DB.Model(&Execution{}).
+		Where("id IN ? AND cancelled_at IS NULL", ids).
+		UpdateColumns(Execution{Status: status})
+
+	return result.Error
+}
+
+// CancelExecution marks the execution as cancelled with the given status as
+// long as its current status is one of the cancellable statuses. Returns true
+// if the execution was cancelled.
+func (c Client) CancelExecution(id string, status string, cancellable []string) (bool, error) {
+	now := time.Now()
+
+	result := c.DB.Model(&Execution{}).
+		Where("id = ? AND cancelled_at IS NULL AND status IN ?", id, cancellable).
+		UpdateColumns(Execution{Status: status, CancelledAt: &now})
+
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListExecutions returns the executions matching the filter ordered by the
+// newest first. The ordering is by the created time and then the id to ensure
+// a stable ordering, meaning executions inserted while paging through the list
+// will not shift the executions on the following pages.
+func (c Client) ListExecutions(filter *ExecutionFilter) ([]Execution, error) {
+	var executions []Execution
+
+	query := c.DB.Model(&Execution{}).Where("tenant_id = ?", filter.TenantID)
+
+	if filter.Language != "" {
+		query = query.Where("language = ?", filter.Language)
+	}
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	if filter.TestStatus != "" {
+		query = query.Where("test_status = ?", filter.TestStatus)
+	}
+
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *filter.CreatedBefore)
+	}
+
+	if filter.After != nil {
+		query = query.Where("(created_at, id) < (?, ?)", filter.After.CreatedAt, filter.After.ID)
+	}
+
+	result := query.Order("created_at DESC").Order("id DESC").Limit(filter.Limit).Find(&executions)
+	return executions, result.Error
+}
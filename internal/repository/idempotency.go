@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+type IdempotencyKey struct {
+	// The scope of the key, allowing different callers to use the same key
+	// without conflicting with each other.
+	Scope string `gorm:"primarykey"`
+	Key   string `gorm:"primarykey"`
+
+	// The execution that was created when the key was first used.
+	ExecutionID string
+
+	// Completed is set once the execution has been created and queued, until
+	// then the claim is still in progress and could be released.
+	Completed bool
+
+	CreatedAt time.Time
+}
+
+// ClaimIdempotencyKey attempts to claim the key for the execution, replacing
+// any existing claim created before expiredBefore or any claim still in
+// progress created before abandonedBefore. Returns the claim that owns the
+// key, which will be of a different execution if the key has already been
+// claimed and has not yet expired.
+func (c Client) ClaimIdempotencyKey(key *IdempotencyKey, expiredBefore time.Time, abandonedBefore time.Time) (IdempotencyKey, error) {
+	createdAt := clause.Column{Table: "idempotency_keys", Name: "created_at"}
+
+	result := c.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "scope"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"execution_id", "completed", "created_at"}),
+		Where: clause.Where{Exprs: []clause.Expression{clause.Or(
+			clause.Lt{Column: createdAt, Value: expiredBefore},
+			clause.And(
+				clause.Eq{Column: clause.Column{Table: "idempotency_keys", Name: "completed"}, Value: false},
+				clause.Lt{Column: createdAt, Value: abandonedBefore},
+			),
+		)}},
+	}).Create(key)
+
+	if result.Error != nil {
+		return IdempotencyKey{}, result.Error
+	}
+
+	existing := IdempotencyKey{}
+
+	result = c.DB.Where("scope = ? AND key = ?", key.Scope, key.Key).First(&existing)
+	return existing, result.Error
+}
+
+// CompleteIdempotencyKey marks the claim of the execution on the key as
+// complete, repeated requests are then given the id of the execution.
+func (c Client) CompleteIdempotencyKey(key *IdempotencyKey) error {
+	result := c.DB.Model(&IdempotencyKey{}).
+		Where("scope = ? AND key = ? AND execution_id = ?", key.Scope, key.Key, key.ExecutionID).
+		UpdateColumn("completed", true)
+
+	return result.Error
+}
+
+// ReleaseIdempotencyKey removes the claim of the execution on the key, allowing
+// the key to be used again.
+func (c Client) ReleaseIdempotencyKey(key *IdempotencyKey) error {
+	result := c.DB.Where("scope = ? AND key = ? AND execution_id = ?", key.Scope, key.Key, key.ExecutionID).
+		Delete(&IdempotencyKey{})
+
+	return result.Error
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// LanguageStatus is the status of a language as last published by a loader,
+// including the version of the toolchain within the language image.
+type LanguageStatus struct {
+	Language string `gorm:"primarykey"`
+	Version  string
+
+	// Available is true if the language image was available when last checked,
+	// otherwise Error describes why the language is unavailable.
+	Available bool
+	Error     string
+
+	CheckedAt time.Time
+}
+
+// UpsertLanguageStatus creates or replaces the status of the language.
+func (c Client) UpsertLanguageStatus(status *LanguageStatus) error {
+	result := c.DB.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "language"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"version", "available", "error", "checked_at",
+		}),
+	}).Create(status)
+	return result.Error
+}
+
+func (c Client) GetLanguageStatuses() ([]LanguageStatus, error) {
+	var statuses []LanguageStatus
+
+	result := c.DB.Find(&statuses)
+	return statuses, result.Error
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -21,16 +23,48 @@ func NewRepository(connectionURL string) (Repository, error) {
 		return nil, pingErr
 	}
 
-	migrateErr := db.AutoMigrate(&Execution{})
+	migrateErr := db.AutoMigrate(&Execution{}, &ExecutionTestCase{}, &ExecutionTestGroup{}, &Batch{}, &IdempotencyKey{}, &WebhookDelivery{}, &APIKey{}, &TenantLimit{}, &Source{}, &Artifact{}, &LanguageStatus{})
 
 	return Client{DB: db}, migrateErr
 }
 
 type Repository interface {
-	InsertExecution(execution *Execution) error
+	InsertExecution(execution *Execution, limit *InFlightLimit) error
 	UpdateExecution(id string, columns *Execution) (bool, error)
 	UpdateExecutionStatus(id string, status string) error
+	UpdateExecutionsStatus(ids []string, status string) error
+	CancelExecution(id string, status string, cancellable []string) (bool, error)
 	GetExecution(id string) (Execution, error)
+	ListExecutions(filter *ExecutionFilter) ([]Execution, error)
+	InsertExecutionTestCases(testCases []*ExecutionTestCase) error
+	GetExecutionTestCases(executionID string) ([]ExecutionTestCase, error)
+	InsertExecutionTestGroups(groups []*ExecutionTestGroup) error
+	GetExecutionTestGroups(executionID string) ([]ExecutionTestGroup, error)
+	InsertBatch(batch *Batch, executions []*Execution, limit *InFlightLimit) error
+	GetBatch(id string) (Batch, error)
+	GetBatchStatusCounts(batchID string) ([]StatusCount, error)
+	ClaimIdempotencyKey(key *IdempotencyKey, expiredBefore time.Time, abandonedBefore time.Time) (IdempotencyKey, error)
+	CompleteIdempotencyKey(key *IdempotencyKey) error
+	ReleaseIdempotencyKey(key *IdempotencyKey) error
+	InsertWebhookDelivery(delivery *WebhookDelivery) error
+	GetWebhookDeliveries(executionID string) ([]WebhookDelivery, error)
+	InsertAPIKey(key *APIKey) error
+	GetAPIKey(hash string) (APIKey, error)
+	GetTenantLimit(tenantID string) (TenantLimit, error)
+	UpsertTenantLimit(limit *TenantLimit) error
+	InsertSource(source *Source) error
+	GetSource(id string) (Source, error)
+	InsertArtifacts(artifacts []*Artifact) error
+	GetArtifacts(executionID string) ([]Artifact, error)
+	GetArtifact(executionID string, path string) (Artifact, error)
+	UpsertLanguageStatus(status *LanguageStatus) error
+	GetLanguageStatuses() ([]LanguageStatus, error)
+	Ping() error
+}
+
+// Ping ensures the database connection is still alive.
+func (c Client) Ping() error {
+	return pingTest(c.DB)
 }
 
 func pingTest(db *gorm.DB) error {
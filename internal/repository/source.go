@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"time"
+)
+
+// Source is a source uploaded ahead of creating the compile request, the
+// files themselves are stored alongside the files of the executions.
+type Source struct {
+	ID string `gorm:"primarykey"`
+
+	// TenantID is the tenant that uploaded the source, only the same tenant
+	// can compile the source.
+	TenantID string `gorm:"index"`
+
+	// Files are the paths of every file of the source.
+	Files     []string `gorm:"serializer:json"`
+	SizeBytes int64
+
+	CreatedAt time.Time
+}
+
+func (c Client) InsertSource(source *Source) error {
+	result := c.DB.Create(source)
+	return result.Error
+}
+
+func (c Client) GetSource(id string) (Source, error) {
+	source := Source{}
+
+	result := c.DB.Where("id = ?", id).First(&source)
+	return source, result.Error
+}
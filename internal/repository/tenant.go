@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TenantLimit overrides the default limits for a single tenant, any zero value
+// falls back to the default limit.
+type TenantLimit struct {
+	TenantID string `gorm:"primarykey"`
+
+	// The number of requests per second allowed, with a burst of up to
+	// RequestBurst requests.
+	RequestsPerSecond float64
+	RequestBurst      int
+
+	// The maximum number of executions that can be queued or running at once.
+	MaxInFlightExecutions int64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (c Client) GetTenantLimit(tenantID string) (TenantLimit, error) {
+	limit := TenantLimit{}
+
+	result := c.DB.Where("tenant_id = ?", tenantID).First(&limit)
+	return limit, result.Error
+}
+
+// UpsertTenantLimit creates the limits of the tenant or replaces the existing
+// limits if already defined.
+func (c Client) UpsertTenantLimit(limit *TenantLimit) error {
+	result := c.DB.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "tenant_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"requests_per_second", "request_burst", "max_in_flight_executions", "updated_at",
+		}),
+	}).Create(limit)
+
+	return result.Error
+}
+
+// ErrInFlightLimitReached is returned when inserting the executions would
+// exceed the in-flight execution limit of the tenant.
+var ErrInFlightLimitReached = errors.New("in-flight execution limit reached")
+
+// InFlightLimit is the maximum number of executions of the tenant which can
+// have one of the in-flight statuses at once.
+type InFlightLimit struct {
+	TenantID string
+	Statuses []string
+	Max      int64
+}
+
+// reserveInFlight locks the tenant for the remainder of the transaction and
+// ensures the executions can be inserted without exceeding the limit. The
+// lock ensures concurrent inserts of the tenant cannot all pass the check.
+func reserveInFlight(tx *gorm.DB, limit *InFlightLimit, executions int64) error {
+	if limit == nil || limit.Max <= 0 {
+		return nil
+	}
+
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", limit.TenantID).Error; err != nil {
+		return err
+	}
+
+	var count int64
+
+	result := tx.Model(&Execution{}).
+		Where("tenant_id = ? AND status IN ?", limit.TenantID, limit.Statuses).
+		Count(&count)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if count+executions > limit.Max {
+		return ErrInFlightLimitReached
+	}
+
+	return nil
+}
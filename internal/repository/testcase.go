@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"time"
+)
+
+type ExecutionTestCase struct {
+	ExecutionID string `gorm:"primarykey"`
+	Index       int    `gorm:"primarykey;autoIncrement:false"`
+
+	Status     string
+	TestStatus string
+
+	RuntimeMs       int64
+	RuntimeMemoryMb float64
+
+	Score          float64
+	CheckerMessage string
+
+	InteractorRuntimeMs       int64
+	InteractorRuntimeMemoryMb float64
+
+	Diff *ExecutionTestCaseDiff `gorm:"serializer:json"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ExecutionTestCaseDiff is the diff of the output of a failed test case and
+// the expected output.
+type ExecutionTestCaseDiff struct {
+	Line          int    `json:"line"`
+	Column        int    `json:"column"`
+	Token         int    `json:"token"`
+	Expected      string `json:"expected"`
+	Actual        string `json:"actual"`
+	ExpectedLines int    `json:"expectedLines"`
+	ActualLines   int    `json:"actualLines"`
+	UnifiedDiff   string `json:"unifiedDiff,omitempty"`
+}
+
+func (c Client) InsertExecutionTestCases(testCases []*ExecutionTestCase) error {
+	if len(testCases) == 0 {
+		return nil
+	}
+
+	result := c.DB.Create(testCases)
+	return result.Error
+}
+
+func (c Client) GetExecutionTestCases(executionID string) ([]ExecutionTestCase, error) {
+	var testCases []ExecutionTestCase
+
+	result := c.DB.Where("execution_id = ?", executionID).Order("index").Find(&testCases)
+	return testCases, result.Error
+}
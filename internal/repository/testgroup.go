@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"time"
+)
+
+type ExecutionTestGroup struct {
+	ExecutionID string `gorm:"primarykey"`
+	Index       int    `gorm:"primarykey;autoIncrement:false"`
+
+	Name       string
+	TestStatus string
+
+	Score  float64
+	Weight float64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (c Client) InsertExecutionTestGroups(groups []*ExecutionTestGroup) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	result := c.DB.Create(groups)
+	return result.Error
+}
+
+func (c Client) GetExecutionTestGroups(executionID string) ([]ExecutionTestGroup, error) {
+	var groups []ExecutionTestGroup
+
+	result := c.DB.Where("execution_id = ?", executionID).Order("index").Find(&groups)
+	return groups, result.Error
+}
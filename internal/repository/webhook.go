@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"time"
+)
+
+// WebhookDelivery is a single attempt of delivering the completion webhook of
+// an execution to its callback url.
+type WebhookDelivery struct {
+	ID uint `gorm:"primarykey"`
+
+	ExecutionID string `gorm:"index"`
+	URL         string
+	Attempt     int
+	StatusCode  int
+	Error       string
+
+	CreatedAt time.Time
+}
+
+func (c Client) InsertWebhookDelivery(delivery *WebhookDelivery) error {
+	result := c.DB.Create(delivery)
+	return result.Error
+}
+
+func (c Client) GetWebhookDeliveries(executionID string) ([]WebhookDelivery, error) {
+	var deliveries []WebhookDelivery
+
+	result := c.DB.Where("execution_id = ?", executionID).Order("attempt").Find(&deliveries)
+	return deliveries, result.Error
+}
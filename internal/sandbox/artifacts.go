@@ -0,0 +1,177 @@
+package sandbox
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// ArtifactsDirectory is the directory within the sandbox the code can write
+// files into, e.g. /input/artifacts. Every file within the directory is
+// collected once the code has run.
+const ArtifactsDirectory = "artifacts"
+
+// Artifact is a file collected from the artifacts directory by the runner.
+type Artifact struct {
+	// The path of the file relative to the artifacts directory.
+	Path      string `json:"path"`
+	SizeBytes int64  `json:"sizeBytes"`
+}
+
+// ArtifactFile is the content of a collected artifact.
+type ArtifactFile struct {
+	Path string
+	Data []byte
+}
+
+// ArtifactFileName returns the name the artifact at the given path is stored
+// as alongside the other files of the execution.
+func ArtifactFileName(artifactPath string) string {
+	return filepath.ToSlash(filepath.Join(ArtifactsDirectory, artifactPath))
+}
+
+// CollectArtifacts walks the artifacts directory in lexical order, returning
+// every regular file within the limits. Files which do not fit within the
+// remaining size, are beyond the maximum number of files, are not regular
+// files such as symlinks or have unsupported names are skipped.
+func CollectArtifacts(root string, maxBytes int64, maxFiles int) ([]*Artifact, error) {
+	artifacts := make([]*Artifact, 0)
+	var size int64
+
+	err := filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && filePath == root {
+				return fs.SkipDir
+			}
+
+			return err
+		}
+
+		if entry.IsDir() || len(artifacts) >= maxFiles {
+			return nil
+		}
+
+		relativePath, _ := filepath.Rel(root, filePath)
+		relativePath = filepath.ToSlash(relativePath)
+
+		if !entry.Type().IsRegular() || ValidateSourcePath(relativePath) != nil {
+			log.Warn().Str("path", relativePath).Msg("skipping unsupported artifact")
+			return nil
+		}
+
+		info, err := entry.Info()
+
+		if err != nil {
+			return err
+		}
+
+		if size+info.Size() > maxBytes {
+			log.Warn().Str("path", relativePath).Int64("size", info.Size()).Msg("skipping artifact exceeding size limit")
+			return nil
+		}
+
+		size += info.Size()
+		artifacts = append(artifacts, &Artifact{Path: relativePath, SizeBytes: info.Size()})
+
+		return nil
+	})
+
+	return artifacts, err
+}
+
+// readArtifacts reads the artifacts listed by the runner from the artifacts
+// directory. The list is written from within the sandbox, so each artifact is
+// validated again to ensure only regular files within the directory and the
+// limits are read.
+func readArtifacts(root string, artifacts []*Artifact, maxBytes int64, maxFiles int) ([]*ArtifactFile, error) {
+	files := make([]*ArtifactFile, 0, len(artifacts))
+	remaining := maxBytes
+
+	if info, err := os.Lstat(root); err != nil || !info.IsDir() {
+		return files, errors.New("artifacts directory is not a directory")
+	}
+
+	for _, artifact := range artifacts {
+		if len(files) >= maxFiles {
+			break
+		}
+
+		if err := ValidateSourcePath(artifact.Path); err != nil {
+			return files, errors.Wrapf(err, "invalid artifact %q", artifact.Path)
+		}
+
+		data, err := readArtifact(root, artifact.Path, remaining)
+
+		if err != nil {
+			return files, errors.Wrapf(err, "failed to read artifact %q", artifact.Path)
+		}
+
+		remaining -= int64(len(data))
+		files = append(files, &ArtifactFile{Path: artifact.Path, Data: data})
+	}
+
+	return files, nil
+}
+
+// readArtifact reads the regular file at the given path within the root as
+// long as it is within the remaining size. Every element of the path is
+// checked to not be a symlink, since these would be resolved outside the
+// sandbox. The container has been removed by this point so the files can no
+// longer change while being read.
+func readArtifact(root string, artifactPath string, remaining int64) ([]byte, error) {
+	filePath := root
+
+	for _, segment := range strings.Split(artifactPath, "/") {
+		filePath = filepath.Join(filePath, segment)
+		info, err := os.Lstat(filePath)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return nil, errors.New("artifact path contains a symlink")
+		}
+	}
+
+	file, err := os.Open(filePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.Mode().IsRegular() {
+		return nil, errors.New("artifact is not a regular file")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, remaining+1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > remaining {
+		return nil, errors.New("artifact exceeds the remaining size")
+	}
+
+	return data, nil
+}
+
+// SupportsBinary returns true if the language compiles to a single binary
+// which can be collected as an artifact.
+func (l *LanguageCompiler) SupportsBinary() bool {
+	return l.binaryFile != ""
+}
@@ -0,0 +1,110 @@
+package sandbox
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeArtifact(t *testing.T, root string, name string, content string) {
+	path := filepath.Join(root, filepath.FromSlash(name))
+
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestCollectArtifacts(t *testing.T) {
+	root := t.TempDir()
+
+	writeArtifact(t, root, "a.csv", "1,2,3")
+	writeArtifact(t, root, "b.png", strings.Repeat("b", 20))
+	writeArtifact(t, root, "plots/c.png", "ccc")
+	writeArtifact(t, root, "d e.txt", "unsupported name")
+	writeArtifact(t, root, "f.txt", "f")
+	writeArtifact(t, root, "g.txt", "g")
+
+	assert.NoError(t, os.Symlink("/etc/passwd", filepath.Join(root, "link")))
+
+	t.Run("should collect regular files within the limits", func(t *testing.T) {
+		artifacts, err := CollectArtifacts(root, 10, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*Artifact{
+			{Path: "a.csv", SizeBytes: 5},
+			{Path: "f.txt", SizeBytes: 1},
+			{Path: "g.txt", SizeBytes: 1},
+		}, artifacts)
+	})
+
+	t.Run("should collect nothing when the directory does not exist", func(t *testing.T) {
+		artifacts, err := CollectArtifacts(filepath.Join(root, "missing"), 10, 3)
+
+		assert.NoError(t, err)
+		assert.Empty(t, artifacts)
+	})
+}
+
+func TestReadArtifacts(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+
+	writeArtifact(t, root, "plots/a.png", "aaa")
+	writeArtifact(t, outside, "secret", "secret")
+
+	assert.NoError(t, os.Symlink(filepath.Join(outside, "secret"), filepath.Join(root, "secret")))
+	assert.NoError(t, os.Symlink(outside, filepath.Join(root, "linked")))
+
+	tests := []struct {
+		name      string
+		artifacts []*Artifact
+		maxBytes  int64
+		wantFiles []*ArtifactFile
+		wantErr   string
+	}{{
+		name:      "should read the listed artifacts",
+		artifacts: []*Artifact{{Path: "plots/a.png"}},
+		maxBytes:  10,
+		wantFiles: []*ArtifactFile{{Path: "plots/a.png", Data: []byte("aaa")}},
+	}, {
+		name:      "should reject artifacts exceeding the size",
+		artifacts: []*Artifact{{Path: "plots/a.png"}},
+		maxBytes:  2,
+		wantFiles: []*ArtifactFile{},
+		wantErr:   "artifact exceeds the remaining size",
+	}, {
+		name:      "should reject symlinked artifacts",
+		artifacts: []*Artifact{{Path: "secret"}},
+		maxBytes:  10,
+		wantFiles: []*ArtifactFile{},
+		wantErr:   "artifact path contains a symlink",
+	}, {
+		name:      "should reject artifacts within symlinked directories",
+		artifacts: []*Artifact{{Path: "linked/secret"}},
+		maxBytes:  10,
+		wantFiles: []*ArtifactFile{},
+		wantErr:   "artifact path contains a symlink",
+	}, {
+		name:      "should reject artifacts outside the directory",
+		artifacts: []*Artifact{{Path: "../secret"}},
+		maxBytes:  10,
+		wantFiles: []*ArtifactFile{},
+		wantErr:   `invalid artifact "../secret"`,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := readArtifacts(root, tt.artifacts, tt.maxBytes, 5)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantFiles, files)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package sandbox
+
+import (
+	"fmt"
+	"math"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// CheckerDirectory is the directory the source of the checker is stored under
+// alongside the other files of the execution.
+const CheckerDirectory = "checker"
+
+// The exit codes of a checker following the testlib convention.
+const (
+	checkerExitAccepted          = 0
+	checkerExitWrongAnswer       = 1
+	checkerExitPresentationError = 2
+	checkerExitDirt              = 4
+	checkerExitPoints            = 7
+	checkerExitUnexpectedEOF     = 8
+
+	// checkerExitPartialBase is added to the percentage of the score given
+	// to a partially correct output, e.g. 100 for half of the score. This is
+	// the exit code of quitf(_pc(n), ...) only when testlib is built for
+	// TESTSYS, i.e. TESTSYS is defined before including testlib.h, otherwise
+	// _pc(n) exits with n and is read as any other exit code. Checkers built
+	// without TESTSYS should give partial scores with quitp instead.
+	checkerExitPartialBase = 50
+	checkerExitPartialMax  = checkerExitPartialBase + 200
+)
+
+// maxCheckerMessageLength is the maximum length of the message kept from the
+// error output of the checker.
+const maxCheckerMessageLength = 1024
+
+// Checker is the program judging the output of the code instead of comparing
+// it with the expected output, allowing tests with many valid outputs.
+type Checker struct {
+	// The compiler of the language the checker is written in.
+	Compiler *LanguageCompiler
+	// The source code of the checker.
+	SourceCode string
+}
+
+// Check is a single run of a checker, judging the output of the code for a
+// single test. Following the testlib convention the checker is executed with
+// the paths of the input, the output and the answer as its arguments.
+type Check struct {
+	Input  []string
+	Output []string
+	Answer []string
+}
+
+// CheckerResult is the verdict of a checker for the output of a single test.
+type CheckerResult struct {
+	TestStatus ContainerTestStatus
+	Score      float64
+	Message    string
+}
+
+// CheckerFileName returns the name the source of the checker is stored as
+// alongside the other files of the execution.
+func CheckerFileName(sourceFile string) string {
+	return path.Join(CheckerDirectory, sourceFile)
+}
+
+// NewCheckerRequest returns the request running the checker against the
+// output of every test case of the response which finished running, along
+// with the index of the test case of each check. The checker is executed in
+// its own sandbox with the limits of the machine profile.
+func NewCheckerRequest(request *Request, resp *Response, checker *Checker) (*Request, []int) {
+	checkerRequest := &Request{
+		ID:               request.ID + "-checker",
+		ExecutionProfile: GetProfileForMachine(),
+		Path:             request.Path + "-checker",
+		SourceCode:       checker.SourceCode,
+		Compiler:         checker.Compiler,
+	}
+
+	var checked []int
+
+	for i, testCase := range resp.TestCases {
+		if i >= len(request.Tests) || testCase.Status != Finished {
+			continue
+		}
+
+		checkerRequest.Checks = append(checkerRequest.Checks, &Check{
+			Input:  request.Tests[i].StdinData,
+			Output: testCase.Output,
+			Answer: request.Tests[i].ExpectedStdoutData,
+		})
+
+		checked = append(checked, i)
+	}
+
+	return checkerRequest, checked
+}
+
+// ApplyCheckerResponse replaces the test status and the score of each of the
+// checked test cases with the verdict of the checker. If the checker did not
+// produce a verdict for a test case, e.g. the checker failed to compile, the
+// test case is reported as not ran.
+func ApplyCheckerResponse(resp *Response, checked []int, checkerResp *Response) {
+	for i, index := range checked {
+		result := &CheckerResult{TestStatus: TestNotRan, Message: "the checker did not run"}
+
+		if checkerResp != nil && checkerResp.Status == CompilationFailed {
+			result.Message = "the checker failed to compile"
+		}
+
+		if checkerResp != nil && i < len(checkerResp.TestCases) {
+			result = ParseCheckerResult(checkerResp.TestCases[i])
+		}
+
+		resp.TestCases[index].TestStatus = result.TestStatus
+		resp.TestCases[index].Score = result.Score
+		resp.TestCases[index].CheckerMessage = result.Message
+
+		// the output is judged by the checker, a diff of the expected output
+		// does not describe why the test failed.
+		resp.TestCases[index].Diff = nil
+	}
+
+	aggregateTestCaseResponses(resp, true)
+}
+
+// ParseCheckerResult returns the verdict of the checker from the exit code and
+// the error output of a single run, following the testlib convention. The
+// points reported by quitp are the score of the test, clamped between 0 and 1.
+func ParseCheckerResult(run *TestCaseResponse) *CheckerResult {
+	return parseJudgeResult("checker", run)
+}
+
+// parseJudgeResult returns the verdict of the named judge, either a checker
+// or an interactor, from the exit code and the error output of a single run.
+func parseJudgeResult(name string, run *TestCaseResponse) *CheckerResult {
+	message := strings.TrimSpace(strings.Join(run.OutputError, "\n"))
+
+	if len(message) > maxCheckerMessageLength {
+		message = message[:maxCheckerMessageLength]
+	}
+
+	if run.Status != Finished {
+		return &CheckerResult{
+			TestStatus: TestNotRan,
+			Message:    fmt.Sprintf("the %s did not finish: %s", name, run.Status),
+		}
+	}
+
+	switch code := run.ExitCode; {
+	case code == checkerExitAccepted:
+		return &CheckerResult{TestStatus: TestPassed, Score: 1, Message: message}
+	case code == checkerExitWrongAnswer, code == checkerExitPresentationError,
+		code == checkerExitDirt, code == checkerExitUnexpectedEOF:
+		return &CheckerResult{TestStatus: TestFailed, Message: message}
+	case code == checkerExitPoints:
+		score, err := parseCheckerPoints(name, message)
+
+		if err != nil {
+			return &CheckerResult{TestStatus: TestNotRan, Message: err.Error()}
+		}
+
+		return newScoredCheckerResult(score, message)
+	case code >= checkerExitPartialBase && code <= checkerExitPartialMax:
+		return newScoredCheckerResult(float64(code-checkerExitPartialBase)/100, message)
+	}
+
+	return &CheckerResult{
+		TestStatus: TestNotRan,
+		Message:    strings.TrimSpace(fmt.Sprintf("the %s failed with exit code %d: %s", name, run.ExitCode, message)),
+	}
+}
+
+// newScoredCheckerResult returns the result of the given score, clamped
+// between 0 and 1.
+func newScoredCheckerResult(score float64, message string) *CheckerResult {
+	switch {
+	case score >= 1:
+		return &CheckerResult{TestStatus: TestPassed, Score: 1, Message: message}
+	case score <= 0:
+		return &CheckerResult{TestStatus: TestFailed, Message: message}
+	}
+
+	return &CheckerResult{TestStatus: TestPartial, Score: score, Message: message}
+}
+
+// parseCheckerPoints parses the points written by testlib when quitting with
+// points, e.g. "points 0.5 two of the four answers are correct".
+func parseCheckerPoints(name string, message string) (float64, error) {
+	fields := strings.Fields(message)
+
+	if len(fields) > 0 && fields[0] == "points" {
+		fields = fields[1:]
+	}
+
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("the %s reported points without a score", name)
+	}
+
+	score, err := strconv.ParseFloat(fields[0], 64)
+
+	if err != nil || math.IsNaN(score) {
+		return 0, fmt.Errorf("the %s reported invalid points %q", name, fields[0])
+	}
+
+	return score, nil
+}
+
+// writeCheckFiles writes down the input, output and answer of each check,
+// returning the arguments of each run of the checker.
+func writeCheckFiles(root string, checks []*Check) ([][]string, error) {
+	if len(checks) == 0 {
+		return nil, nil
+	}
+
+	runArguments := make([][]string, 0, len(checks))
+
+	for i, check := range checks {
+		files := []struct {
+			name string
+			data []string
+		}{
+			{name: fmt.Sprintf("check-input-%d", i), data: check.Input},
+			{name: fmt.Sprintf("check-output-%d", i), data: check.Output},
+			{name: fmt.Sprintf("check-answer-%d", i), data: check.Answer},
+		}
+
+		arguments := make([]string, 0, len(files))
+
+		for _, file := range files {
+			if err := writeInputFile(filepath.Join(root, file.name), file.data); err != nil {
+				return nil, err
+			}
+
+			arguments = append(arguments, path.Join("/input", file.name))
+		}
+
+		runArguments = append(runArguments, arguments)
+	}
+
+	return runArguments, nil
+}
@@ -0,0 +1,171 @@
+package sandbox
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCheckerResult(t *testing.T) {
+	tests := []struct {
+		name string
+		run  *TestCaseResponse
+		want *CheckerResult
+	}{{
+		name: "should accept exit code 0",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 0, OutputError: []string{"ok 3 numbers"}},
+		want: &CheckerResult{TestStatus: TestPassed, Score: 1, Message: "ok 3 numbers"},
+	}, {
+		name: "should reject a wrong answer",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 1, OutputError: []string{"wrong answer 1st numbers differ"}},
+		want: &CheckerResult{TestStatus: TestFailed, Message: "wrong answer 1st numbers differ"},
+	}, {
+		name: "should reject a presentation error",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 2},
+		want: &CheckerResult{TestStatus: TestFailed},
+	}, {
+		name: "should reject an unexpected end of file",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 8},
+		want: &CheckerResult{TestStatus: TestFailed},
+	}, {
+		name: "should give a partial score from points",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 7, OutputError: []string{"points 0.25 one of four"}},
+		want: &CheckerResult{TestStatus: TestPartial, Score: 0.25, Message: "points 0.25 one of four"},
+	}, {
+		name: "should clamp points above the full score",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 7, OutputError: []string{"points 2"}},
+		want: &CheckerResult{TestStatus: TestPassed, Score: 1, Message: "points 2"},
+	}, {
+		name: "should clamp negative points",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 7, OutputError: []string{"points -3"}},
+		want: &CheckerResult{TestStatus: TestFailed, Message: "points -3"},
+	}, {
+		name: "should clamp infinite points",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 7, OutputError: []string{"points +Inf"}},
+		want: &CheckerResult{TestStatus: TestPassed, Score: 1, Message: "points +Inf"},
+	}, {
+		name: "should fail points which are not a number",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 7, OutputError: []string{"points NaN"}},
+		want: &CheckerResult{TestStatus: TestNotRan, Message: "the checker reported invalid points \"NaN\""},
+	}, {
+		name: "should fail points without a score",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 7, OutputError: []string{"points"}},
+		want: &CheckerResult{TestStatus: TestNotRan, Message: "the checker reported points without a score"},
+	}, {
+		name: "should give a partial score from a partially correct exit code",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 100, OutputError: []string{"partially correct"}},
+		want: &CheckerResult{TestStatus: TestPartial, Score: 0.5, Message: "partially correct"},
+	}, {
+		name: "should reject a partially correct exit code without a score",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 50},
+		want: &CheckerResult{TestStatus: TestFailed},
+	}, {
+		name: "should not run when the checker fails",
+		run:  &TestCaseResponse{Status: Finished, ExitCode: 3, OutputError: []string{"FAIL answer is invalid"}},
+		want: &CheckerResult{TestStatus: TestNotRan, Message: "the checker failed with exit code 3: FAIL answer is invalid"},
+	}, {
+		name: "should not run when the checker did not finish",
+		run:  &TestCaseResponse{Status: TimeLimitExceeded, ExitCode: -1},
+		want: &CheckerResult{TestStatus: TestNotRan, Message: "the checker did not finish: TimeLimitExceeded"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseCheckerResult(tt.run))
+		})
+	}
+}
+
+func TestNewCheckerRequest(t *testing.T) {
+	request := &Request{
+		ID:   "id",
+		Path: "/tmp/id",
+		Tests: []*Test{
+			{StdinData: []string{"1"}, ExpectedStdoutData: []string{"2"}},
+			{StdinData: []string{"3"}, ExpectedStdoutData: []string{"4"}},
+			{StdinData: []string{"5"}, ExpectedStdoutData: []string{"6"}},
+		},
+	}
+
+	resp := &Response{TestCases: []*TestCaseResponse{
+		{Status: Finished, Output: []string{"a"}},
+		{Status: TimeLimitExceeded},
+		{Status: Finished, Output: []string{"c"}},
+	}}
+
+	checker := &Checker{Compiler: Compilers["python"], SourceCode: "print(1)"}
+	checkerRequest, checked := NewCheckerRequest(request, resp, checker)
+
+	assert.Equal(t, []int{0, 2}, checked)
+	assert.Equal(t, "id-checker", checkerRequest.ID)
+	assert.Equal(t, "/tmp/id-checker", checkerRequest.Path)
+	assert.Equal(t, "print(1)", checkerRequest.SourceCode)
+	assert.Empty(t, checkerRequest.Tests)
+	assert.Equal(t, []*Check{
+		{Input: []string{"1"}, Output: []string{"a"}, Answer: []string{"2"}},
+		{Input: []string{"5"}, Output: []string{"c"}, Answer: []string{"6"}},
+	}, checkerRequest.Checks)
+}
+
+func TestApplyCheckerResponse(t *testing.T) {
+	newResponse := func() *Response {
+		return &Response{Status: Finished, TestCases: []*TestCaseResponse{
+			{Status: Finished, TestStatus: TestFailed},
+			{Status: Finished, TestStatus: TestFailed},
+		}}
+	}
+
+	t.Run("should replace the verdicts with the checker verdicts", func(t *testing.T) {
+		resp := newResponse()
+
+		ApplyCheckerResponse(resp, []int{0, 1}, &Response{TestCases: []*TestCaseResponse{
+			{Status: Finished, ExitCode: 0},
+			{Status: Finished, ExitCode: 7, OutputError: []string{"points 0.5"}},
+		}})
+
+		assert.Equal(t, TestPassed, resp.TestCases[0].TestStatus)
+		assert.Equal(t, 1.0, resp.TestCases[0].Score)
+		assert.Equal(t, TestPartial, resp.TestCases[1].TestStatus)
+		assert.Equal(t, 0.5, resp.TestCases[1].Score)
+		assert.Equal(t, TestPartial, resp.TestStatus)
+	})
+
+	t.Run("should not run the tests when the checker failed to compile", func(t *testing.T) {
+		resp := newResponse()
+
+		ApplyCheckerResponse(resp, []int{0, 1}, &Response{Status: CompilationFailed})
+
+		assert.Equal(t, TestNotRan, resp.TestCases[0].TestStatus)
+		assert.Equal(t, "the checker failed to compile", resp.TestCases[0].CheckerMessage)
+		assert.Equal(t, TestNotRan, resp.TestStatus)
+	})
+
+	t.Run("should not run the tests without a checker response", func(t *testing.T) {
+		resp := newResponse()
+
+		ApplyCheckerResponse(resp, []int{1}, nil)
+
+		assert.Equal(t, TestFailed, resp.TestCases[0].TestStatus)
+		assert.Equal(t, TestNotRan, resp.TestCases[1].TestStatus)
+		assert.Equal(t, "the checker did not run", resp.TestCases[1].CheckerMessage)
+		assert.Equal(t, TestFailed, resp.TestStatus)
+	})
+}
+
+func TestWriteCheckFiles(t *testing.T) {
+	root := t.TempDir()
+
+	runArguments, err := writeCheckFiles(root, []*Check{
+		{Input: []string{"1 2"}, Output: []string{"3"}, Answer: []string{"3"}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"/input/check-input-0", "/input/check-output-0", "/input/check-answer-0"}}, runArguments)
+
+	content, err := os.ReadFile(filepath.Join(root, "check-input-0"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1 2\n", string(content))
+}
@@ -0,0 +1,141 @@
+package sandbox
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type ComparatorMode int
+
+const (
+	// CompareExact requires every line to be exactly the same.
+	CompareExact ComparatorMode = iota
+	// CompareIgnoreTrailingWhitespace ignores the whitespace at the end of each
+	// line, including carriage returns, and any blank lines at the end.
+	CompareIgnoreTrailingWhitespace
+	// CompareTokens compares the whitespace separated tokens, ignoring how the
+	// tokens are split across lines.
+	CompareTokens
+	// CompareCaseInsensitive requires every line to be the same ignoring case.
+	CompareCaseInsensitive
+	// CompareFloat compares the tokens, numeric tokens are equal if within the
+	// absolute or the relative epsilon of each other.
+	CompareFloat
+	// CompareUnorderedLines requires the same lines in any order, ignoring the
+	// trailing whitespace of each line and any blank lines at the end.
+	CompareUnorderedLines
+)
+
+// Comparator determines if the output of the code matches the expected output
+// of the test.
+type Comparator struct {
+	Mode ComparatorMode `json:"mode"`
+
+	// The maximum absolute and relative difference of numeric tokens when
+	// comparing floats, either is enough for the tokens to be equal.
+	AbsoluteEpsilon float64 `json:"absolute_epsilon"`
+	RelativeEpsilon float64 `json:"relative_epsilon"`
+}
+
+// Compare returns true if the output matches the expected output, a nil
+// comparator compares the output exactly.
+func (c *Comparator) Compare(output []string, expected []string) bool {
+	if c == nil {
+		return compareLines(output, expected, equalExact)
+	}
+
+	switch c.Mode {
+	case CompareIgnoreTrailingWhitespace:
+		return compareLines(trimTrailingWhitespace(output), trimTrailingWhitespace(expected), equalExact)
+	case CompareTokens:
+		return compareLines(tokenize(output), tokenize(expected), equalExact)
+	case CompareCaseInsensitive:
+		return compareLines(output, expected, strings.EqualFold)
+	case CompareFloat:
+		return compareLines(tokenize(output), tokenize(expected), c.equalFloat)
+	case CompareUnorderedLines:
+		return compareLines(sortLines(trimTrailingWhitespace(output)), sortLines(trimTrailingWhitespace(expected)), equalExact)
+	default:
+		return compareLines(output, expected, equalExact)
+	}
+}
+
+// compareLines returns true if both have the same number of lines and every
+// line is equal.
+func compareLines(output []string, expected []string, equal func(a, b string) bool) bool {
+	if len(output) != len(expected) {
+		return false
+	}
+
+	for i := range expected {
+		if !equal(output[i], expected[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalExact(a, b string) bool {
+	return a == b
+}
+
+// equalFloat returns true if the tokens are the same, or both are numbers
+// within the absolute or relative epsilon of each other.
+func (c *Comparator) equalFloat(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	x, xErr := strconv.ParseFloat(a, 64)
+	y, yErr := strconv.ParseFloat(b, 64)
+
+	if xErr != nil || yErr != nil || math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0) {
+		return false
+	}
+
+	diff := math.Abs(x - y)
+
+	if diff <= c.AbsoluteEpsilon {
+		return true
+	}
+
+	return diff <= c.RelativeEpsilon*math.Max(math.Abs(x), math.Abs(y))
+}
+
+// trimTrailingWhitespace returns the lines without the trailing whitespace of
+// each line and without the blank lines at the end.
+func trimTrailingWhitespace(lines []string) []string {
+	trimmed := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		trimmed = append(trimmed, strings.TrimRight(line, " \t\r\n\v\f"))
+	}
+
+	for len(trimmed) > 0 && trimmed[len(trimmed)-1] == "" {
+		trimmed = trimmed[:len(trimmed)-1]
+	}
+
+	return trimmed
+}
+
+// tokenize returns the whitespace separated tokens of every line.
+func tokenize(lines []string) []string {
+	tokens := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		tokens = append(tokens, strings.Fields(line)...)
+	}
+
+	return tokens
+}
+
+// sortLines returns a sorted copy of the lines.
+func sortLines(lines []string) []string {
+	sorted := append([]string(nil), lines...)
+	sort.Strings(sorted)
+
+	return sorted
+}
@@ -0,0 +1,135 @@
+package sandbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComparatorCompare(t *testing.T) {
+	tests := []struct {
+		name       string
+		comparator *Comparator
+		output     []string
+		expected   []string
+		want       bool
+	}{{
+		name:     "nil should match exact output",
+		output:   []string{"1", "2"},
+		expected: []string{"1", "2"},
+		want:     true,
+	}, {
+		name:     "nil should reject trailing whitespace",
+		output:   []string{"1 "},
+		expected: []string{"1"},
+		want:     false,
+	}, {
+		name:       "exact should match exact output",
+		comparator: &Comparator{Mode: CompareExact},
+		output:     []string{"hello", "world"},
+		expected:   []string{"hello", "world"},
+		want:       true,
+	}, {
+		name:       "exact should reject a different number of lines",
+		comparator: &Comparator{Mode: CompareExact},
+		output:     []string{"hello", "world", ""},
+		expected:   []string{"hello", "world"},
+		want:       false,
+	}, {
+		name:       "ignore trailing whitespace should ignore spaces and carriage returns",
+		comparator: &Comparator{Mode: CompareIgnoreTrailingWhitespace},
+		output:     []string{"hello  ", "world\r", "", ""},
+		expected:   []string{"hello", "world"},
+		want:       true,
+	}, {
+		name:       "ignore trailing whitespace should not ignore leading whitespace",
+		comparator: &Comparator{Mode: CompareIgnoreTrailingWhitespace},
+		output:     []string{" hello"},
+		expected:   []string{"hello"},
+		want:       false,
+	}, {
+		name:       "ignore trailing whitespace should not ignore blank lines in between",
+		comparator: &Comparator{Mode: CompareIgnoreTrailingWhitespace},
+		output:     []string{"hello", "", "world"},
+		expected:   []string{"hello", "world"},
+		want:       false,
+	}, {
+		name:       "tokens should ignore how the tokens are split",
+		comparator: &Comparator{Mode: CompareTokens},
+		output:     []string{"1  2", "\t3", ""},
+		expected:   []string{"1 2 3"},
+		want:       true,
+	}, {
+		name:       "tokens should reject different tokens",
+		comparator: &Comparator{Mode: CompareTokens},
+		output:     []string{"1 2 4"},
+		expected:   []string{"1 2 3"},
+		want:       false,
+	}, {
+		name:       "case insensitive should ignore case",
+		comparator: &Comparator{Mode: CompareCaseInsensitive},
+		output:     []string{"YES", "No"},
+		expected:   []string{"yes", "no"},
+		want:       true,
+	}, {
+		name:       "case insensitive should reject different lines",
+		comparator: &Comparator{Mode: CompareCaseInsensitive},
+		output:     []string{"yes", "yes"},
+		expected:   []string{"yes", "no"},
+		want:       false,
+	}, {
+		name:       "float should accept numbers within the absolute epsilon",
+		comparator: &Comparator{Mode: CompareFloat, AbsoluteEpsilon: 1e-6},
+		output:     []string{"0.3333333 1"},
+		expected:   []string{"0.333333", "1.0"},
+		want:       true,
+	}, {
+		name:       "float should reject numbers outside the absolute epsilon",
+		comparator: &Comparator{Mode: CompareFloat, AbsoluteEpsilon: 1e-6},
+		output:     []string{"0.333"},
+		expected:   []string{"0.333333"},
+		want:       false,
+	}, {
+		name:       "float should accept numbers within the relative epsilon",
+		comparator: &Comparator{Mode: CompareFloat, RelativeEpsilon: 1e-6},
+		output:     []string{"1000000001"},
+		expected:   []string{"1000000000"},
+		want:       true,
+	}, {
+		name:       "float should reject numbers outside the relative epsilon",
+		comparator: &Comparator{Mode: CompareFloat, RelativeEpsilon: 1e-6},
+		output:     []string{"1.01"},
+		expected:   []string{"1"},
+		want:       false,
+	}, {
+		name:       "float should compare words exactly",
+		comparator: &Comparator{Mode: CompareFloat, AbsoluteEpsilon: 1},
+		output:     []string{"answer 1.5"},
+		expected:   []string{"Answer 1"},
+		want:       false,
+	}, {
+		name:       "float should reject not a number",
+		comparator: &Comparator{Mode: CompareFloat, AbsoluteEpsilon: 1},
+		output:     []string{"NaN"},
+		expected:   []string{"1"},
+		want:       false,
+	}, {
+		name:       "unordered lines should accept lines in any order",
+		comparator: &Comparator{Mode: CompareUnorderedLines},
+		output:     []string{"b", "c ", "a", ""},
+		expected:   []string{"a", "b", "c"},
+		want:       true,
+	}, {
+		name:       "unordered lines should reject duplicated lines",
+		comparator: &Comparator{Mode: CompareUnorderedLines},
+		output:     []string{"a", "a", "b"},
+		expected:   []string{"a", "b", "b"},
+		want:       false,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.comparator.Compare(tt.output, tt.expected))
+		})
+	}
+}
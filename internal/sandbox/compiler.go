@@ -1,12 +1,8 @@
 package sandbox
 
 import (
-	"embed"
 	"fmt"
 	"strings"
-	"sync"
-
-	"github.com/rs/zerolog/log"
 )
 
 type LanguageCompiler struct {
@@ -24,6 +20,23 @@ type LanguageCompiler struct {
 	// The steps used to compile the application, these are skipped if
 	// interpreter is true.
 	compileSteps []string
+	// The steps used to compile and run multi-file submissions, the files are
+	// written into the project directory. If no run steps are defined the
+	// language does not support multi-file submissions.
+	projectRunSteps     string
+	projectCompileSteps []string
+	// The path within the sandbox of the compiled binary, collected as an
+	// artifact when requested. Not every language produces a single binary.
+	binaryFile string
+	// The command used to print the version of the toolchain within the
+	// language image, the first line of its output is the version.
+	versionCommand string
+	// The steps used to compile and run an interactor written in the
+	// language, the source is moved by the runner into the private
+	// interactor directory. If no run steps are defined the language cannot
+	// be used for interactors.
+	interactorRunSteps     string
+	interactorCompileSteps []string
 	// If the given compilerName is an interpreter or not, since based on this
 	// action we would need to create additional steps for compiling to a file
 	// if not.
@@ -51,6 +64,9 @@ var Compilers = map[string]*LanguageCompiler{
 		Dockerfile:         "python2",
 		Language:           "Python 2 (pypy)",
 		runSteps:           "pypy /input/solution.py",
+		projectRunSteps:    "pypy /input/project/{{.EntryFile}}",
+		versionCommand:     "pypy --version",
+		interactorRunSteps: "pypy /judge/solution.py",
 		Interpreter:        true,
 		VirtualMachineName: "virtual_machine_python2",
 		SourceFile:         "solution.py",
@@ -63,6 +79,9 @@ var Compilers = map[string]*LanguageCompiler{
 		Dockerfile:         "python",
 		Language:           "Python (pypy)",
 		runSteps:           "pypy /input/solution.py",
+		projectRunSteps:    "pypy /input/project/{{.EntryFile}}",
+		versionCommand:     "pypy --version",
+		interactorRunSteps: "pypy /judge/solution.py",
 		Interpreter:        true,
 		VirtualMachineName: "virtual_machine_python",
 		SourceFile:         "solution.py",
@@ -75,6 +94,9 @@ var Compilers = map[string]*LanguageCompiler{
 		Dockerfile:         "node",
 		Language:           "NodeJs (Javascript)",
 		runSteps:           "node /input/solution.js",
+		projectRunSteps:    "node /input/project/{{.EntryFile}}",
+		versionCommand:     "node --version",
+		interactorRunSteps: "node /judge/solution.js",
 		Interpreter:        true,
 		VirtualMachineName: "virtual_machine_node",
 		SourceFile:         "solution.js",
@@ -87,6 +109,9 @@ var Compilers = map[string]*LanguageCompiler{
 		Dockerfile:         "ruby",
 		Language:           "Ruby",
 		runSteps:           "ruby /input/solution.rb",
+		projectRunSteps:    "ruby /input/project/{{.EntryFile}}",
+		versionCommand:     "ruby --version",
+		interactorRunSteps: "ruby /judge/solution.rb",
 		Interpreter:        true,
 		VirtualMachineName: "virtual_machine_ruby",
 		SourceFile:         "solution.rb",
@@ -103,6 +128,16 @@ var Compilers = map[string]*LanguageCompiler{
 		compileSteps: []string{
 			"rustc -o /solution /input/solution.rs",
 		},
+		projectRunSteps: "/solution",
+		projectCompileSteps: []string{
+			"rustc -o /solution /input/project/{{.EntryFile}}",
+		},
+		binaryFile:         "/solution",
+		versionCommand:     "rustc --version",
+		interactorRunSteps: "/judge/interactor",
+		interactorCompileSteps: []string{
+			"rustc -o /judge/interactor /judge/solution.rs",
+		},
 		Interpreter:        false,
 		VirtualMachineName: "virtual_machine_rust",
 		SourceFile:         "solution.rs",
@@ -120,6 +155,17 @@ var Compilers = map[string]*LanguageCompiler{
 			"cp /input/solution.go /project/main.go",
 			"go build -o /solution /project/main.go",
 		},
+		projectRunSteps: "/solution",
+		projectCompileSteps: []string{
+			"cp -R /input/project/. /project/",
+			"go build -C /project -o /solution .",
+		},
+		binaryFile:         "/solution",
+		versionCommand:     "go version",
+		interactorRunSteps: "/judge/interactor",
+		interactorCompileSteps: []string{
+			"go build -o /judge/interactor /judge/solution.go",
+		},
 		Interpreter:        false,
 		VirtualMachineName: "virtual_machine_go",
 		SourceFile:         "solution.go",
@@ -136,6 +182,16 @@ var Compilers = map[string]*LanguageCompiler{
 		compileSteps: []string{
 			"gcc -g -O2 -std=gnu11 -static -o /solution /input/solution.c -lm",
 		},
+		projectRunSteps: "/solution",
+		projectCompileSteps: []string{
+			"gcc -g -O2 -std=gnu11 -static -I /input/project -o /solution {{.ProjectFiles \".c\"}} -lm",
+		},
+		binaryFile:         "/solution",
+		versionCommand:     "gcc --version",
+		interactorRunSteps: "/judge/interactor",
+		interactorCompileSteps: []string{
+			"gcc -O2 -std=gnu11 -static -o /judge/interactor /judge/solution.c -lm",
+		},
 		Interpreter:        false,
 		VirtualMachineName: "virtual_machine_gcc",
 		SourceFile:         "solution.c",
@@ -152,6 +208,16 @@ var Compilers = map[string]*LanguageCompiler{
 		compileSteps: []string{
 			"g++ -g -O2 -std=gnu++17 -static -lrt -Wl,--whole-archive -lpthread -Wl,--no-whole-archive -o /solution /input/solution.cpp",
 		},
+		projectRunSteps: "/solution",
+		projectCompileSteps: []string{
+			"g++ -g -O2 -std=gnu++17 -static -lrt -Wl,--whole-archive -lpthread -Wl,--no-whole-archive -I /input/project -o /solution {{.ProjectFiles \".cpp\"}}",
+		},
+		binaryFile:         "/solution",
+		versionCommand:     "g++ --version",
+		interactorRunSteps: "/judge/interactor",
+		interactorCompileSteps: []string{
+			"g++ -O2 -std=gnu++17 -static -o /judge/interactor /judge/solution.cpp",
+		},
 		Interpreter:        false,
 		VirtualMachineName: "virtual_machine_gcc",
 		SourceFile:         "solution.cpp",
@@ -170,6 +236,7 @@ var Compilers = map[string]*LanguageCompiler{
 			"cp /input/solution.fs /{{.ID}}/Program.fs",
 			"dotnet build --configuration Release -o /{{.ID}}-output/ /{{.ID}}/",
 		},
+		versionCommand:     "dotnet --version",
 		Interpreter:        false,
 		VirtualMachineName: "virtual_machine_dotnet",
 		SourceFile:         "solution.fs",
@@ -188,6 +255,14 @@ var Compilers = map[string]*LanguageCompiler{
 			"cp /input/solution.cs /{{.ID}}/Program.cs",
 			"dotnet build --configuration Release -o /{{.ID}}-output/ /{{.ID}}/",
 		},
+		projectRunSteps: "/{{.ID}}-output/template-c",
+		projectCompileSteps: []string{
+			"cp -R /template-c/ /{{.ID}}/",
+			"rm /{{.ID}}/Program.cs",
+			"cp -R /input/project/. /{{.ID}}/",
+			"dotnet build --configuration Release -o /{{.ID}}-output/ /{{.ID}}/",
+		},
+		versionCommand:     "dotnet --version",
 		Interpreter:        false,
 		VirtualMachineName: "virtual_machine_dotnet",
 		SourceFile:         "solution.cs",
@@ -208,6 +283,11 @@ var Compilers = map[string]*LanguageCompiler{
 		compileSteps: []string{
 			"javac /input/Solution.java",
 		},
+		projectRunSteps: "java -Xmx2048m -cp /input/project {{.EntryClass}}",
+		projectCompileSteps: []string{
+			"javac -d /input/project {{.ProjectFiles \".java\"}}",
+		},
+		versionCommand:     "java -version",
 		Interpreter:        false,
 		VirtualMachineName: "virtual_machine_openjdk",
 		SourceFile:         "Solution.java",
@@ -224,6 +304,11 @@ var Compilers = map[string]*LanguageCompiler{
 		compileSteps: []string{
 			"/scalac /input/Solution.scala",
 		},
+		projectRunSteps: "/scala -J-Xmx2048m -cp /input/project {{.EntryClass}}",
+		projectCompileSteps: []string{
+			"/scalac -d /input/project {{.ProjectFiles \".scala\"}}",
+		},
+		versionCommand:     "/scalac -version",
 		Interpreter:        false,
 		VirtualMachineName: "virtual_machine_openjdk",
 		SourceFile:         "Solution.scala",
@@ -240,6 +325,12 @@ var Compilers = map[string]*LanguageCompiler{
 		compileSteps: []string{
 			"/kotlinc solution.kt -include-runtime -d /solution.jar",
 		},
+		projectRunSteps: "java -Xmx2048m -jar /solution.jar",
+		projectCompileSteps: []string{
+			"/kotlinc {{.ProjectFiles \".kt\"}} -include-runtime -d /solution.jar",
+		},
+		binaryFile:         "/solution.jar",
+		versionCommand:     "/kotlinc -version",
 		Interpreter:        false,
 		VirtualMachineName: "virtual_machine_openjdk",
 		SourceFile:         "solution.kt",
@@ -252,6 +343,9 @@ var Compilers = map[string]*LanguageCompiler{
 		Dockerfile:         "php",
 		Language:           "PHP",
 		runSteps:           "php /input/solution.php",
+		projectRunSteps:    "php /input/project/{{.EntryFile}}",
+		versionCommand:     "php --version",
+		interactorRunSteps: "php /judge/solution.php",
 		Interpreter:        true,
 		VirtualMachineName: "virtual_machine_php",
 		SourceFile:         "solution.php",
@@ -262,6 +356,12 @@ var Compilers = map[string]*LanguageCompiler{
 	},
 }
 
+// testInputFile returns the name of the standard input file for the test at
+// the given index.
+func (l *LanguageCompiler) testInputFile(index int) string {
+	return fmt.Sprintf("%s-%d", l.InputFile, index)
+}
+
 // mustGetCompilerByLanguage will return the language compiler for the given
 // provided language or panic.
 func mustGetCompilerByLanguage(language string) *LanguageCompiler {
@@ -271,27 +371,3 @@ func mustGetCompilerByLanguage(language string) *LanguageCompiler {
 
 	panic("language compiler does not exist for language: " + language)
 }
-
-//go:embed templates/*
-var content embed.FS
-
-// CompilerTemplate - this will be filled with the template data for API calls.
-// the data should be small so templates will be in memory always.
-var CompilerTemplate = map[string]string{}
-
-var once sync.Once
-
-func LoadEmbeddedTemplateFiles() {
-	once.Do(func() {
-		for s := range Compilers {
-			data, err := content.ReadFile(fmt.Sprintf("templates/%s.txt", s))
-
-			if err != nil {
-				log.Warn().Str("lang", s).Msg("language does not have a template")
-				continue
-			}
-
-			CompilerTemplate[s] = string(data)
-		}
-	})
-}
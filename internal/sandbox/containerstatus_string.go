@@ -20,11 +20,12 @@ func _() {
 	_ = x[CompilationFailed-9]
 	_ = x[RunTimeError-10]
 	_ = x[NonDeterministicError-11]
+	_ = x[Cancelled-12]
 }
 
-const _ContainerStatus_name = "NotRanCreatedRunningKillingKilledFinishedMemoryConstraintExceededTimeLimitExceededProvidedTestFailedCompilationFailedRunTimeErrorNonDeterministicError"
+const _ContainerStatus_name = "NotRanCreatedRunningKillingKilledFinishedMemoryConstraintExceededTimeLimitExceededProvidedTestFailedCompilationFailedRunTimeErrorNonDeterministicErrorCancelled"
 
-var _ContainerStatus_index = [...]uint8{0, 6, 13, 20, 27, 33, 41, 65, 82, 100, 117, 129, 150}
+var _ContainerStatus_index = [...]uint8{0, 6, 13, 20, 27, 33, 41, 65, 82, 100, 117, 129, 150, 159}
 
 func (i ContainerStatus) String() string {
 	if i < 0 || i >= ContainerStatus(len(_ContainerStatus_index)-1) {
@@ -12,11 +12,12 @@ func _() {
 	_ = x[TestNotRan-1]
 	_ = x[TestFailed-2]
 	_ = x[TestPassed-3]
+	_ = x[TestPartial-4]
 }
 
-const _ContainerTestStatus_name = "NoTestTestNotRanTestFailedTestPassed"
+const _ContainerTestStatus_name = "NoTestTestNotRanTestFailedTestPassedTestPartial"
 
-var _ContainerTestStatus_index = [...]uint8{0, 6, 16, 26, 36}
+var _ContainerTestStatus_index = [...]uint8{0, 6, 16, 26, 36, 47}
 
 func (i ContainerTestStatus) String() string {
 	if i < 0 || i >= ContainerTestStatus(len(_ContainerTestStatus_index)-1) {
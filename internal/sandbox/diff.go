@@ -0,0 +1,326 @@
+package sandbox
+
+import (
+	"fmt"
+	"strings"
+
+	"compile-and-run-sandbox/internal/memory"
+)
+
+// The limits of the diff of the output of a failed test.
+const (
+	// maxDiffExcerptLength is the maximum number of characters of the excerpts
+	// of the first mismatching line, including the characters before the
+	// first mismatching column.
+	maxDiffExcerptLength = 100
+	diffExcerptLeading   = 30
+
+	// maxUnifiedDiffLines is the maximum number of lines of each side
+	// compared by the unified diff, the runner keeps at most 1k lines of
+	// output.
+	maxUnifiedDiffLines = 1_000
+	maxUnifiedDiffSize  = 16 * memory.Kilobyte
+	unifiedDiffContext  = 3
+)
+
+// OutputDiff describes where the output of a failed test first differs from
+// the expected output.
+type OutputDiff struct {
+	// The line and the column of the first mismatch, both starting at 1. The
+	// column is the first character of the line that differs. Not set when
+	// comparing tokens or unordered lines, since the position of a line does
+	// not decide the mismatch.
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
+
+	// The index of the first mismatching token starting at 1, only set when
+	// comparing tokens.
+	Token int `json:"token,omitempty"`
+
+	// The excerpts of the expected and the actual line of the first mismatch,
+	// starting shortly before the mismatching column. When comparing tokens
+	// these are the mismatching tokens, when comparing unordered lines these
+	// are the first mismatching lines once sorted.
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+
+	// The number of lines of the expected and the actual output.
+	ExpectedLines int `json:"expectedLines"`
+	ActualLines   int `json:"actualLines"`
+
+	// The unified diff of the expected and the actual output, if requested.
+	UnifiedDiff string `json:"unifiedDiff,omitempty"`
+}
+
+// NewOutputDiff returns the diff of the output and the expected output, or nil
+// if no mismatch was found. The output is compared the same as the
+// comparator, e.g. the tokens are compared when comparing tokens and the
+// sorted lines are compared when comparing unordered lines. The unified diff
+// is of the compared lines, or of the tokens one per line.
+func NewOutputDiff(comparator *Comparator, output []string, expected []string, includeUnified bool) *OutputDiff {
+	diff := &OutputDiff{
+		ExpectedLines: len(expected),
+		ActualLines:   len(output),
+	}
+
+	mode := CompareExact
+	equal := equalExact
+
+	if comparator != nil {
+		mode = comparator.Mode
+	}
+
+	switch mode {
+	case CompareIgnoreTrailingWhitespace:
+		output, expected = trimTrailingWhitespace(output), trimTrailingWhitespace(expected)
+	case CompareUnorderedLines:
+		output, expected = sortLines(trimTrailingWhitespace(output)), sortLines(trimTrailingWhitespace(expected))
+	case CompareCaseInsensitive:
+		equal = strings.EqualFold
+	case CompareTokens:
+		output, expected = tokenize(output), tokenize(expected)
+	case CompareFloat:
+		output, expected = tokenize(output), tokenize(expected)
+		equal = comparator.equalFloat
+	case CompareExact:
+	}
+
+	index := firstMismatch(output, expected, equal)
+
+	if index < 0 {
+		return nil
+	}
+
+	actual, wanted := valueAt(output, index), valueAt(expected, index)
+
+	switch mode {
+	case CompareTokens, CompareFloat:
+		diff.Token = index + 1
+		diff.Expected, diff.Actual = excerpt(wanted, 0), excerpt(actual, 0)
+	case CompareUnorderedLines:
+		diff.Expected, diff.Actual = excerpt(wanted, 0), excerpt(actual, 0)
+	case CompareExact, CompareIgnoreTrailingWhitespace, CompareCaseInsensitive:
+		column := firstMismatchingColumn(actual, wanted, mode == CompareCaseInsensitive)
+
+		diff.Line = index + 1
+		diff.Column = column + 1
+		diff.Expected = excerpt(wanted, column)
+		diff.Actual = excerpt(actual, column)
+	}
+
+	if includeUnified {
+		diff.UnifiedDiff = unifiedDiff(output, expected, equal)
+	}
+
+	return diff
+}
+
+// valueAt returns the value at the index, or empty if the index is past the
+// end of the values.
+func valueAt(values []string, index int) string {
+	if index < len(values) {
+		return values[index]
+	}
+
+	return ""
+}
+
+// firstMismatch returns the index of the first line or token which differs, or
+// is missing from one of the sides, -1 if every line is equal.
+func firstMismatch(output []string, expected []string, equal func(a, b string) bool) int {
+	for i := 0; i < len(output) || i < len(expected); i++ {
+		if i >= len(output) || i >= len(expected) || !equal(output[i], expected[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// firstMismatchingColumn returns the index of the first character which
+// differs between the lines.
+func firstMismatchingColumn(a string, b string, foldCase bool) int {
+	ar, br := []rune(a), []rune(b)
+	column := 0
+
+	for column < len(ar) && column < len(br) {
+		if ar[column] != br[column] && (!foldCase || !strings.EqualFold(string(ar[column]), string(br[column]))) {
+			break
+		}
+
+		column++
+	}
+
+	return column
+}
+
+// excerpt returns the part of the line around the column, marking the line as
+// truncated with "..." on either side.
+func excerpt(line string, column int) string {
+	runes := []rune(line)
+	start := max(0, column-diffExcerptLeading)
+	end := min(len(runes), start+maxDiffExcerptLength)
+
+	result := string(runes[start:end])
+
+	if start > 0 {
+		result = "..." + result
+	}
+
+	if end < len(runes) {
+		result += "..."
+	}
+
+	return result
+}
+
+// diffOperation is a single line of the unified diff.
+type diffOperation struct {
+	kind byte
+	line string
+
+	// the line number of the line on the expected and the actual side.
+	expectedLine int
+	actualLine   int
+}
+
+// unifiedDiff returns the unified diff of the expected and the actual output,
+// truncated once the maximum size is reached.
+func unifiedDiff(output []string, expected []string, equal func(a, b string) bool) string {
+	output = output[:min(len(output), maxUnifiedDiffLines)]
+	expected = expected[:min(len(expected), maxUnifiedDiffLines)]
+
+	operations := diffOperations(output, expected, equal)
+
+	builder := strings.Builder{}
+	builder.WriteString("--- expected\n+++ actual\n")
+
+	for start := 0; start < len(operations); {
+		if operations[start].kind == ' ' {
+			start++
+			continue
+		}
+
+		end := start
+
+		// changes separated by no more than twice the context are within the
+		// same hunk, otherwise the hunk ends with the context after it.
+		for {
+			for end < len(operations) && operations[end].kind != ' ' {
+				end++
+			}
+
+			unchanged := 0
+
+			for end+unchanged < len(operations) && operations[end+unchanged].kind == ' ' {
+				unchanged++
+			}
+
+			if end+unchanged < len(operations) && unchanged <= unifiedDiffContext*2 {
+				end += unchanged
+				continue
+			}
+
+			end += min(unchanged, unifiedDiffContext)
+			break
+		}
+
+		if !writeHunk(&builder, operations[max(0, start-unifiedDiffContext):end]) {
+			builder.WriteString("... diff truncated\n")
+			break
+		}
+
+		start = end
+	}
+
+	return builder.String()
+}
+
+// writeHunk writes the hunk into the builder, returning false without
+// writing if the hunk would exceed the maximum size of the diff.
+func writeHunk(builder *strings.Builder, hunk []diffOperation) bool {
+	expectedStart, actualStart := hunk[0].expectedLine, hunk[0].actualLine
+	var expectedCount, actualCount int
+
+	lines := strings.Builder{}
+
+	for _, operation := range hunk {
+		if operation.kind != '+' {
+			expectedCount++
+		}
+
+		if operation.kind != '-' {
+			actualCount++
+		}
+
+		lines.WriteByte(operation.kind)
+		lines.WriteString(operation.line)
+		lines.WriteByte('\n')
+	}
+
+	header := fmt.Sprintf("@@ -%s +%s @@\n", hunkRange(expectedStart, expectedCount), hunkRange(actualStart, actualCount))
+
+	if int64(builder.Len()+len(header)+lines.Len()) > maxUnifiedDiffSize.Bytes() {
+		return false
+	}
+
+	builder.WriteString(header)
+	builder.WriteString(lines.String())
+
+	return true
+}
+
+// hunkRange returns the range of the hunk on one of the sides, an empty range
+// starts at the line before the hunk.
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start-1)
+	}
+
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// diffOperations returns the operations turning the expected output into the
+// actual output from the longest common subsequence of the lines.
+func diffOperations(output []string, expected []string, equal func(a, b string) bool) []diffOperation {
+	// lengths[i][j] is the length of the longest common subsequence of the
+	// expected lines from i and the output lines from j.
+	lengths := make([][]uint16, len(expected)+1)
+
+	for i := range lengths {
+		lengths[i] = make([]uint16, len(output)+1)
+	}
+
+	for i := len(expected) - 1; i >= 0; i-- {
+		for j := len(output) - 1; j >= 0; j-- {
+			if equal(expected[i], output[j]) {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	operations := make([]diffOperation, 0, len(expected)+len(output))
+	i, j := 0, 0
+
+	for i < len(expected) || j < len(output) {
+		operation := diffOperation{expectedLine: i + 1, actualLine: j + 1}
+
+		switch {
+		case i < len(expected) && j < len(output) && equal(expected[i], output[j]):
+			operation.kind, operation.line = ' ', output[j]
+			i, j = i+1, j+1
+		case j == len(output) || (i < len(expected) && lengths[i+1][j] >= lengths[i][j+1]):
+			operation.kind, operation.line = '-', expected[i]
+			i++
+		default:
+			operation.kind, operation.line = '+', output[j]
+			j++
+		}
+
+		operations = append(operations, operation)
+	}
+
+	return operations
+}
@@ -0,0 +1,171 @@
+package sandbox
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOutputDiff(t *testing.T) {
+	tests := []struct {
+		name       string
+		comparator *Comparator
+		output     []string
+		expected   []string
+		want       *OutputDiff
+	}{{
+		name:     "should find the first mismatching line and column",
+		output:   []string{"1 2 3", "4 5 7"},
+		expected: []string{"1 2 3", "4 5 6"},
+		want:     &OutputDiff{Line: 2, Column: 5, Expected: "4 5 6", Actual: "4 5 7", ExpectedLines: 2, ActualLines: 2},
+	}, {
+		name:     "should find a missing line",
+		output:   []string{"1"},
+		expected: []string{"1", "2"},
+		want:     &OutputDiff{Line: 2, Column: 1, Expected: "2", Actual: "", ExpectedLines: 2, ActualLines: 1},
+	}, {
+		name:     "should find an extra line",
+		output:   []string{"1", "2"},
+		expected: []string{"1"},
+		want:     &OutputDiff{Line: 2, Column: 1, Expected: "", Actual: "2", ExpectedLines: 1, ActualLines: 2},
+	}, {
+		name:     "should truncate long lines around the column",
+		output:   []string{strings.Repeat("a", 50) + "b" + strings.Repeat("a", 200)},
+		expected: []string{strings.Repeat("a", 251)},
+		want: &OutputDiff{
+			Line:          1,
+			Column:        51,
+			Expected:      "..." + strings.Repeat("a", 100) + "...",
+			Actual:        "..." + strings.Repeat("a", 30) + "b" + strings.Repeat("a", 69) + "...",
+			ExpectedLines: 1,
+			ActualLines:   1,
+		},
+	}, {
+		name:     "should count columns in characters",
+		output:   []string{"héllo"},
+		expected: []string{"hélla"},
+		want:     &OutputDiff{Line: 1, Column: 5, Expected: "hélla", Actual: "héllo", ExpectedLines: 1, ActualLines: 1},
+	}, {
+		name:       "should ignore trailing whitespace",
+		comparator: &Comparator{Mode: CompareIgnoreTrailingWhitespace},
+		output:     []string{"1 ", "2", ""},
+		expected:   []string{"1", "3"},
+		want:       &OutputDiff{Line: 2, Column: 1, Expected: "3", Actual: "2", ExpectedLines: 2, ActualLines: 3},
+	}, {
+		name:       "should ignore case",
+		comparator: &Comparator{Mode: CompareCaseInsensitive},
+		output:     []string{"YES", "yes"},
+		expected:   []string{"yes", "no"},
+		want:       &OutputDiff{Line: 2, Column: 1, Expected: "no", Actual: "yes", ExpectedLines: 2, ActualLines: 2},
+	}, {
+		name:       "should find the first mismatching token",
+		comparator: &Comparator{Mode: CompareTokens},
+		output:     []string{"1 2", "3 5"},
+		expected:   []string{"1", "2 3 4"},
+		want:       &OutputDiff{Token: 4, Expected: "4", Actual: "5", ExpectedLines: 2, ActualLines: 2},
+	}, {
+		name:       "should find a missing token split across lines",
+		comparator: &Comparator{Mode: CompareTokens},
+		output:     []string{"1 2", "3"},
+		expected:   []string{"1 2 3 4"},
+		want:       &OutputDiff{Token: 4, Expected: "4", Actual: "", ExpectedLines: 1, ActualLines: 2},
+	}, {
+		name:       "should find the first token outside the epsilon",
+		comparator: &Comparator{Mode: CompareFloat, AbsoluteEpsilon: 1e-6},
+		output:     []string{"1.0000001", "2.5"},
+		expected:   []string{"1 2.4"},
+		want:       &OutputDiff{Token: 2, Expected: "2.4", Actual: "2.5", ExpectedLines: 1, ActualLines: 2},
+	}, {
+		name:       "should compare the sorted lines",
+		comparator: &Comparator{Mode: CompareUnorderedLines},
+		output:     []string{"b", "a", "d"},
+		expected:   []string{"a", "b", "c"},
+		want:       &OutputDiff{Expected: "c", Actual: "d", ExpectedLines: 3, ActualLines: 3},
+	}, {
+		name:       "should return nil for unordered lines in another order",
+		comparator: &Comparator{Mode: CompareUnorderedLines},
+		output:     []string{"b", "a "},
+		expected:   []string{"a", "b"},
+	}, {
+		name:       "should return nil for the same tokens split across lines",
+		comparator: &Comparator{Mode: CompareTokens},
+		output:     []string{"1", "2 3"},
+		expected:   []string{"1 2", "3"},
+	}, {
+		name:     "should return nil without a mismatch",
+		output:   []string{"1"},
+		expected: []string{"1"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewOutputDiff(tt.comparator, tt.output, tt.expected, false))
+		})
+	}
+}
+
+func TestNewOutputDiffUnified(t *testing.T) {
+	t.Run("should group changes into hunks with context", func(t *testing.T) {
+		expected := make([]string, 0, 20)
+
+		for i := 1; i <= 20; i++ {
+			expected = append(expected, fmt.Sprint(i))
+		}
+
+		output := append([]string{}, expected...)
+		output[1] = "x"
+		output = append(output[:15], output[16:]...)
+
+		diff := NewOutputDiff(nil, output, expected, true)
+
+		assert.Equal(t, "--- expected\n+++ actual\n"+
+			"@@ -1,5 +1,5 @@\n 1\n-2\n+x\n 3\n 4\n 5\n"+
+			"@@ -13,7 +13,6 @@\n 13\n 14\n 15\n-16\n 17\n 18\n 19\n", diff.UnifiedDiff)
+	})
+
+	t.Run("should truncate the diff at the size limit", func(t *testing.T) {
+		output, expected := make([]string, 0, 1000), make([]string, 0, 1000)
+
+		for i := 0; i < 1000; i++ {
+			output = append(output, strings.Repeat("a", 40), "")
+			expected = append(expected, strings.Repeat("b", 40), "")
+		}
+
+		diff := NewOutputDiff(nil, output, expected, true)
+
+		assert.LessOrEqual(t, int64(len(diff.UnifiedDiff)), maxUnifiedDiffSize.Bytes()+64)
+		assert.True(t, strings.HasSuffix(diff.UnifiedDiff, "... diff truncated\n"))
+	})
+
+	t.Run("should not include the unified diff unless requested", func(t *testing.T) {
+		diff := NewOutputDiff(nil, []string{"1"}, []string{"2"}, false)
+
+		assert.Empty(t, diff.UnifiedDiff)
+	})
+}
+
+func TestGetResponseDiff(t *testing.T) {
+	container := &Container{
+		request: &Request{
+			Tests: []*Test{
+				{ExpectedStdoutData: []string{"1"}},
+				{ExpectedStdoutData: []string{"2"}},
+			},
+		},
+		executionResponse: &ExecutionResponse{
+			Status: Finished,
+			Runs: []*ExecutionRunResponse{
+				{Status: Finished, Output: []string{"1"}},
+				{Status: Finished, Output: []string{"3"}},
+			},
+		},
+	}
+
+	resp := container.GetResponse()
+
+	assert.Nil(t, resp.TestCases[0].Diff)
+	assert.Equal(t, &OutputDiff{Line: 1, Column: 1, Expected: "2", Actual: "3", ExpectedLines: 1, ActualLines: 1},
+		resp.TestCases[1].Diff)
+}
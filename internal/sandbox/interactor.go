@@ -0,0 +1,149 @@
+package sandbox
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"compile-and-run-sandbox/internal/memory"
+)
+
+// InteractorDirectory is the directory within the sandbox the files of the
+// interactor are written into, it is also the directory the source is stored
+// under alongside the other files of the execution.
+const InteractorDirectory = "interactor"
+
+// InteractorPrivateDirectory is the directory within the container the runner
+// moves the files of the interactor into, only readable by the runner and the
+// interactor. The code runs as another user and cannot read the source, the
+// input or the answer of the interactor, or write into the transcript.
+const InteractorPrivateDirectory = "/judge"
+
+// maxTranscriptSize is the maximum size of the transcript of a single run,
+// anything exchanged after the limit is reached is not recorded.
+const maxTranscriptSize = memory.Megabyte
+
+// Interactor is the program running alongside the code of an interactive
+// problem, the standard output of each is connected to the standard input of
+// the other. The interactor decides the verdict of each test.
+type Interactor struct {
+	// The compiler of the language the interactor is written in, the
+	// interactor is executed within the same image as the code.
+	Compiler *LanguageCompiler
+	// The source code of the interactor.
+	SourceCode string
+}
+
+// InteractorParameters are the parameters of the interactor given to the
+// runner alongside the execution parameters.
+type InteractorParameters struct {
+	CompileSteps []string `json:"compileSteps"`
+	Run          string   `json:"run"`
+
+	// The arguments of each run, following the testlib convention the
+	// interactor is executed with the paths of the input, the output and the
+	// answer of the test within the private interactor directory.
+	RunArguments [][]string `json:"runArguments"`
+
+	// The maximum size of the transcript of each run, copied into the
+	// artifacts directory once the code has exited.
+	MaxTranscriptBytes int64 `json:"maxTranscriptBytes"`
+}
+
+// InteractorFileName returns the name the source of the interactor is stored
+// as alongside the other files of the execution.
+func InteractorFileName(sourceFile string) string {
+	return path.Join(InteractorDirectory, sourceFile)
+}
+
+// TranscriptFileName returns the name of the transcript of the run at the
+// given index within the artifacts directory.
+func TranscriptFileName(index int) string {
+	return fmt.Sprintf("transcript-%d.txt", index)
+}
+
+// SupportsInteractor returns true if an interactor can be written in the
+// language.
+func (l *LanguageCompiler) SupportsInteractor() bool {
+	return l.interactorRunSteps != ""
+}
+
+// ValidateInteractor ensures the interactor written in the given language
+// can run alongside code written in the language of the compiler.
+func (l *LanguageCompiler) ValidateInteractor(interactor *LanguageCompiler) error {
+	if !interactor.SupportsInteractor() {
+		return fmt.Errorf("interactors cannot be written in %s", interactor.Language)
+	}
+
+	if interactor.VirtualMachineName != l.VirtualMachineName {
+		return fmt.Errorf("interactors written in %s cannot run alongside %s", interactor.Language, l.Language)
+	}
+
+	return nil
+}
+
+// ParseInteractorResult returns the verdict of the interactor of a single run,
+// following the same testlib convention as checkers.
+func ParseInteractorResult(run *ExecutionRunResponse) *CheckerResult {
+	return parseJudgeResult("interactor", &TestCaseResponse{
+		Status:      run.InteractorStatus,
+		OutputError: run.InteractorOutputErr,
+		ExitCode:    run.InteractorExitCode,
+	})
+}
+
+// writeInteractorFiles writes down the source of the interactor and the
+// input and the answer of each test into the interactor directory, returning
+// the parameters of the interactor. The input of each test is passed to the
+// interactor instead of the code.
+func writeInteractorFiles(root string, interactor *Interactor, tests []*Test, runs int) (*InteractorParameters, error) {
+	if interactor == nil {
+		return nil, nil
+	}
+
+	directory := filepath.Join(root, InteractorDirectory)
+
+	if err := os.MkdirAll(directory, 0o750); err != nil {
+		return nil, errors.Wrap(err, "failed to make interactor directory")
+	}
+
+	if err := writeSourceFile(filepath.Join(directory, interactor.Compiler.SourceFile), interactor.SourceCode); err != nil {
+		return nil, err
+	}
+
+	parameters := &InteractorParameters{
+		CompileSteps:       interactor.Compiler.interactorCompileSteps,
+		Run:                interactor.Compiler.interactorRunSteps,
+		RunArguments:       make([][]string, 0, runs),
+		MaxTranscriptBytes: maxTranscriptSize.Bytes(),
+	}
+
+	for i := 0; i < runs; i++ {
+		var input, answer []string
+
+		if i < len(tests) {
+			input, answer = tests[i].StdinData, tests[i].ExpectedStdoutData
+		}
+
+		inputFile, answerFile := fmt.Sprintf("input-%d", i), fmt.Sprintf("answer-%d", i)
+
+		if err := writeInputFile(filepath.Join(directory, inputFile), input); err != nil {
+			return nil, err
+		}
+
+		if err := writeInputFile(filepath.Join(directory, answerFile), answer); err != nil {
+			return nil, err
+		}
+
+		parameters.RunArguments = append(parameters.RunArguments, []string{
+			path.Join(InteractorPrivateDirectory, inputFile),
+			path.Join(InteractorPrivateDirectory, fmt.Sprintf("output-%d", i)),
+			path.Join(InteractorPrivateDirectory, answerFile),
+		})
+	}
+
+	return parameters, nil
+}
@@ -0,0 +1,126 @@
+package sandbox
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguageCompilerValidateInteractor(t *testing.T) {
+	tests := []struct {
+		name       string
+		language   string
+		interactor string
+		wantErr    string
+	}{{
+		name:       "should allow the same language",
+		language:   "python",
+		interactor: "python",
+	}, {
+		name:       "should allow a language sharing the image",
+		language:   "c",
+		interactor: "cpp",
+	}, {
+		name:       "should reject a language with another image",
+		language:   "python",
+		interactor: "cpp",
+		wantErr:    "interactors written in C++ cannot run alongside Python (pypy)",
+	}, {
+		name:       "should reject a language without interactor support",
+		language:   "java",
+		interactor: "java",
+		wantErr:    "interactors cannot be written in Java",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Compilers[tt.language].ValidateInteractor(Compilers[tt.interactor])
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestParseInteractorResult(t *testing.T) {
+	t.Run("should accept exit code 0", func(t *testing.T) {
+		result := ParseInteractorResult(&ExecutionRunResponse{
+			InteractorStatus:    Finished,
+			InteractorOutputErr: []string{"ok guessed in 7 queries"},
+		})
+
+		assert.Equal(t, &CheckerResult{TestStatus: TestPassed, Score: 1, Message: "ok guessed in 7 queries"}, result)
+	})
+
+	t.Run("should reject a wrong answer", func(t *testing.T) {
+		result := ParseInteractorResult(&ExecutionRunResponse{InteractorStatus: Finished, InteractorExitCode: 1})
+
+		assert.Equal(t, TestFailed, result.TestStatus)
+	})
+
+	t.Run("should not run when the interactor did not finish", func(t *testing.T) {
+		result := ParseInteractorResult(&ExecutionRunResponse{InteractorStatus: MemoryConstraintExceeded, InteractorExitCode: -1})
+
+		assert.Equal(t, &CheckerResult{
+			TestStatus: TestNotRan,
+			Message:    "the interactor did not finish: MemoryConstraintExceeded",
+		}, result)
+	})
+}
+
+func TestInteractiveGetResponse(t *testing.T) {
+	container := &Container{
+		request: &Request{
+			Tests:      []*Test{{StdinData: []string{"37"}}, {StdinData: []string{"74"}}},
+			Interactor: &Interactor{Compiler: Compilers["python"]},
+		},
+		executionResponse: &ExecutionResponse{
+			Status: Finished,
+			Runs: []*ExecutionRunResponse{
+				{Status: Finished, InteractorStatus: Finished, InteractorRuntime: 2_000_000},
+				{Status: Finished, InteractorStatus: Finished, InteractorExitCode: 1},
+			},
+		},
+	}
+
+	resp := container.GetResponse()
+
+	assert.Equal(t, TestPassed, resp.TestCases[0].TestStatus)
+	assert.Equal(t, int64(2), resp.TestCases[0].InteractorRuntime.Milliseconds())
+	assert.Equal(t, TestFailed, resp.TestCases[1].TestStatus)
+	assert.Equal(t, TestFailed, resp.TestStatus)
+}
+
+func TestWriteInteractorFiles(t *testing.T) {
+	root := t.TempDir()
+
+	interactor := &Interactor{Compiler: Compilers["cpp"], SourceCode: "int main() {}"}
+	tests := []*Test{{StdinData: []string{"37"}, ExpectedStdoutData: []string{"37"}}}
+
+	parameters, err := writeInteractorFiles(root, interactor, tests, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "/judge/interactor", parameters.Run)
+	assert.Equal(t, [][]string{{"/judge/input-0", "/judge/output-0", "/judge/answer-0"}}, parameters.RunArguments)
+
+	source, err := os.ReadFile(filepath.Join(root, InteractorDirectory, "solution.cpp"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "int main() {}\r\n", string(source))
+
+	input, err := os.ReadFile(filepath.Join(root, InteractorDirectory, "input-0"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "37\n", string(input))
+
+	answer, err := os.ReadFile(filepath.Join(root, InteractorDirectory, "answer-0"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "37\n", string(answer))
+}
@@ -2,6 +2,9 @@ package sandbox
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -54,12 +57,19 @@ func (s *ContainerManager) AddContainer(ctx context.Context, request *Request) (
 	return containerID, complete, nil
 }
 
+// RemoveContainer removes the container from the manager, freeing its slot.
+// A killed container is removed before its destroy event arrives, so its files
+// are cleaned up here instead.
 func (s *ContainerManager) RemoveContainer(ctx context.Context, containerID string, kill bool) error {
 	if kill {
 		if container := s.getContainer(containerID); container != nil {
 			if err := s.dockerClient.ContainerKill(ctx, container.ID, "SIGKILL"); err != nil {
 				return errors.Wrap(err, "failed to kill the container")
 			}
+
+			if err := container.cleanup(); err != nil {
+				log.Warn().Err(err).Str("id", containerID).Msg("failed to clean up killed container")
+			}
 		}
 	}
 
@@ -85,6 +95,42 @@ func (s *ContainerManager) getContainer(id string) *Container {
 	return nil
 }
 
+// Ping ensures the docker daemon is reachable.
+func (s *ContainerManager) Ping(ctx context.Context) error {
+	_, err := s.dockerClient.Ping(ctx)
+	return errors.Wrap(err, "failed to ping docker daemon")
+}
+
+// CheckLanguageImages ensures the image of every supported language has been
+// built, returning an error listing the missing images.
+func (s *ContainerManager) CheckLanguageImages(ctx context.Context) error {
+	checked := map[string]bool{}
+	var missing []string
+
+	for _, compiler := range Compilers {
+		if checked[compiler.VirtualMachineName] {
+			continue
+		}
+
+		checked[compiler.VirtualMachineName] = true
+
+		if err := s.CheckLanguageImage(ctx, compiler); err != nil {
+			if !client.IsErrNotFound(err) {
+				return err
+			}
+
+			missing = append(missing, compiler.VirtualMachineName)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("missing language images: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
 func (s *ContainerManager) Stop() {
 	log.Info().Msg("stopping sandbox manager")
 	atomic.StoreInt32(&s.stopFlag, 1)
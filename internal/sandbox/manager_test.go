@@ -0,0 +1,59 @@
+package sandbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveContainerKillCleansUp(t *testing.T) {
+	killed := make(chan string, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/kill") {
+			killed <- r.URL.Path
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	defer server.Close()
+
+	dockerClient, err := client.NewClientWithOpts(
+		client.WithHost("tcp://"+strings.TrimPrefix(server.URL, "http://")),
+		client.WithVersion("1.41"),
+	)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "execution")
+	require.NoError(t, os.MkdirAll(filepath.Join(path, ArtifactsDirectory), 0o750))
+
+	container := NewSandboxContainer(&Request{ID: "execution", Path: path}, dockerClient)
+	container.ID = "container"
+	container.complete = make(chan string, 1)
+
+	manager := &ContainerManager{limiter: make(chan string, 1), dockerClient: dockerClient}
+	manager.limiter <- "execution"
+	manager.containers.Store(container.ID, container)
+
+	require.NoError(t, manager.RemoveContainer(context.Background(), container.ID, true))
+
+	assert.Equal(t, "/v1.41/containers/container/kill", <-killed)
+	assert.NoDirExists(t, path, "the files of the killed container should be removed")
+	assert.Empty(t, manager.limiter, "the slot of the killed container should be freed")
+	assert.Nil(t, manager.getContainer(container.ID))
+
+	_, open := <-container.complete
+	assert.False(t, open, "the killed container should be complete")
+
+	// the destroy event can still arrive for the killed container.
+	assert.NoError(t, container.cleanup())
+}
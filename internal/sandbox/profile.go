@@ -44,6 +44,85 @@ type Profile struct {
 	ExecutionMemory memory.Memory
 	// The amount of memory this container is allowed to swap to disk.
 	MemorySwap memory.Memory
+	// The maximum code timeout a request is allowed to override the code
+	// timeout with.
+	MaxCodeTimeout time.Duration
+	// The maximum compile timeout a request is allowed to override the compile
+	// timeout with.
+	MaxCompileTimeout time.Duration
+	// The maximum execution memory a request is allowed to override the
+	// execution memory with. This must not be higher than the container memory.
+	MaxExecutionMemory memory.Memory
+	// The maximum total size of the artifacts collected from the artifacts
+	// directory, artifacts exceeding the remaining size are skipped.
+	MaxArtifactsSize memory.Memory
+	// The maximum number of artifacts collected from the artifacts directory.
+	MaxArtifacts int
+}
+
+// Limits are the optional limits of a single request, overriding the limits
+// of the profile. Any zero value will fall back to the profile value.
+type Limits struct {
+	CodeTimeout     time.Duration
+	CompileTimeout  time.Duration
+	ExecutionMemory memory.Memory
+}
+
+// ValidateLimits ensures the provided limits do not exceed the maximums of the
+// profile, returning an error describing the first limit exceeded.
+func (p *Profile) ValidateLimits(limits *Limits) error {
+	if limits.CodeTimeout > p.MaxCodeTimeout {
+		return fmt.Errorf("time limit of %dms exceeds the maximum of %dms",
+			limits.CodeTimeout.Milliseconds(), p.MaxCodeTimeout.Milliseconds())
+	}
+
+	if limits.CompileTimeout > p.MaxCompileTimeout {
+		return fmt.Errorf("compile time limit of %dms exceeds the maximum of %dms",
+			limits.CompileTimeout.Milliseconds(), p.MaxCompileTimeout.Milliseconds())
+	}
+
+	if limits.ExecutionMemory > p.MaxExecutionMemory {
+		return fmt.Errorf("memory limit of %.0fmb exceeds the maximum of %.0fmb",
+			limits.ExecutionMemory.Megabytes(), p.MaxExecutionMemory.Megabytes())
+	}
+
+	return nil
+}
+
+// WithLimits returns a copy of the profile with the provided limits applied,
+// any limit exceeding the maximums of the profile is capped at the maximum.
+func (p *Profile) WithLimits(limits *Limits) *Profile {
+	profile := *p
+
+	if limits == nil {
+		return &profile
+	}
+
+	if limits.CodeTimeout > 0 {
+		profile.CodeTimeout = minDuration(limits.CodeTimeout, p.MaxCodeTimeout)
+	}
+
+	if limits.CompileTimeout > 0 {
+		profile.CompileTimeout = minDuration(limits.CompileTimeout, p.MaxCompileTimeout)
+	}
+
+	if limits.ExecutionMemory > 0 {
+		profile.ExecutionMemory = limits.ExecutionMemory
+
+		if profile.ExecutionMemory > p.MaxExecutionMemory {
+			profile.ExecutionMemory = p.MaxExecutionMemory
+		}
+	}
+
+	return &profile
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+
+	return b
 }
 
 // ProfileValueMaps A map of profile ids to profiles, this mapping is used
@@ -55,36 +134,56 @@ var _ = map[uint]*Profile{
 // Profiles is a list of all currently supported profiles in the system
 var profiles = map[string]*Profile{
 	"development_linux": {
-		AutoRemove:      true,
-		CodeTimeout:     time.Second * 5,
-		CompileTimeout:  time.Second * 20,
-		ContainerMemory: memory.Gigabyte * 2,
-		ExecutionMemory: memory.Gigabyte,
-		Runtime:         GVisor,
+		AutoRemove:         true,
+		CodeTimeout:        time.Second * 5,
+		CompileTimeout:     time.Second * 20,
+		ContainerMemory:    memory.Gigabyte * 2,
+		ExecutionMemory:    memory.Gigabyte,
+		Runtime:            GVisor,
+		MaxCodeTimeout:     time.Second * 10,
+		MaxCompileTimeout:  time.Second * 30,
+		MaxExecutionMemory: memory.Gigabyte,
+		MaxArtifactsSize:   memory.Megabyte * 10,
+		MaxArtifacts:       20,
 	},
 	"development_windows": {
-		AutoRemove:      true,
-		CodeTimeout:     time.Second * 5,
-		CompileTimeout:  time.Second * 20,
-		ContainerMemory: memory.Gigabyte * 2,
-		ExecutionMemory: memory.Gigabyte,
-		Runtime:         Default,
+		AutoRemove:         true,
+		CodeTimeout:        time.Second * 5,
+		CompileTimeout:     time.Second * 20,
+		ContainerMemory:    memory.Gigabyte * 2,
+		ExecutionMemory:    memory.Gigabyte,
+		Runtime:            Default,
+		MaxCodeTimeout:     time.Second * 10,
+		MaxCompileTimeout:  time.Second * 30,
+		MaxExecutionMemory: memory.Gigabyte,
+		MaxArtifactsSize:   memory.Megabyte * 10,
+		MaxArtifacts:       20,
 	},
 	"production": {
-		AutoRemove:      true,
-		CodeTimeout:     time.Second,
-		CompileTimeout:  time.Second * 10,
-		ContainerMemory: memory.Gigabyte * 2,
-		ExecutionMemory: memory.Gigabyte,
-		Runtime:         GVisor,
+		AutoRemove:         true,
+		CodeTimeout:        time.Second,
+		CompileTimeout:     time.Second * 10,
+		ContainerMemory:    memory.Gigabyte * 2,
+		ExecutionMemory:    memory.Gigabyte,
+		Runtime:            GVisor,
+		MaxCodeTimeout:     time.Second * 5,
+		MaxCompileTimeout:  time.Second * 20,
+		MaxExecutionMemory: memory.Gigabyte,
+		MaxArtifactsSize:   memory.Megabyte * 10,
+		MaxArtifacts:       20,
 	},
 	"staging": {
-		AutoRemove:      true,
-		CodeTimeout:     time.Second * 2,
-		CompileTimeout:  time.Second * 20,
-		ContainerMemory: memory.Gigabyte * 2,
-		ExecutionMemory: memory.Gigabyte,
-		Runtime:         GVisor,
+		AutoRemove:         true,
+		CodeTimeout:        time.Second * 2,
+		CompileTimeout:     time.Second * 20,
+		ContainerMemory:    memory.Gigabyte * 2,
+		ExecutionMemory:    memory.Gigabyte,
+		Runtime:            GVisor,
+		MaxCodeTimeout:     time.Second * 5,
+		MaxCompileTimeout:  time.Second * 20,
+		MaxExecutionMemory: memory.Gigabyte,
+		MaxArtifactsSize:   memory.Megabyte * 10,
+		MaxArtifacts:       20,
 	},
 }
 
@@ -0,0 +1,101 @@
+package sandbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"compile-and-run-sandbox/internal/memory"
+)
+
+func TestProfileValidateLimits(t *testing.T) {
+	profile := &Profile{
+		MaxCodeTimeout:     time.Second * 5,
+		MaxCompileTimeout:  time.Second * 20,
+		MaxExecutionMemory: memory.Gigabyte,
+	}
+
+	tests := []struct {
+		name    string
+		limits  *Limits
+		wantErr string
+	}{{
+		name:   "should allow no limits",
+		limits: &Limits{},
+	}, {
+		name: "should allow limits at the maximum",
+		limits: &Limits{
+			CodeTimeout:     time.Second * 5,
+			CompileTimeout:  time.Second * 20,
+			ExecutionMemory: memory.Gigabyte,
+		},
+	}, {
+		name:    "should reject time limit over the maximum",
+		limits:  &Limits{CodeTimeout: time.Second * 6},
+		wantErr: "time limit of 6000ms exceeds the maximum of 5000ms",
+	}, {
+		name:    "should reject compile time limit over the maximum",
+		limits:  &Limits{CompileTimeout: time.Second * 21},
+		wantErr: "compile time limit of 21000ms exceeds the maximum of 20000ms",
+	}, {
+		name:    "should reject memory limit over the maximum",
+		limits:  &Limits{ExecutionMemory: memory.Gigabyte * 2},
+		wantErr: "memory limit of 2048mb exceeds the maximum of 1024mb",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := profile.ValidateLimits(tt.limits)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestProfileWithLimits(t *testing.T) {
+	profile := &Profile{
+		CodeTimeout:        time.Second,
+		CompileTimeout:     time.Second * 10,
+		ExecutionMemory:    memory.Megabyte * 512,
+		MaxCodeTimeout:     time.Second * 5,
+		MaxCompileTimeout:  time.Second * 20,
+		MaxExecutionMemory: memory.Gigabyte,
+	}
+
+	t.Run("should keep the profile values without limits", func(t *testing.T) {
+		limited := profile.WithLimits(&Limits{})
+
+		assert.Equal(t, profile, limited)
+		assert.NotSame(t, profile, limited)
+	})
+
+	t.Run("should apply the provided limits", func(t *testing.T) {
+		limited := profile.WithLimits(&Limits{
+			CodeTimeout:     time.Second * 2,
+			ExecutionMemory: memory.Megabyte * 256,
+		})
+
+		assert.Equal(t, time.Second*2, limited.CodeTimeout)
+		assert.Equal(t, time.Second*10, limited.CompileTimeout)
+		assert.Equal(t, memory.Megabyte*256, limited.ExecutionMemory)
+		assert.Equal(t, time.Second, profile.CodeTimeout)
+	})
+
+	t.Run("should cap the limits at the profile maximums", func(t *testing.T) {
+		limited := profile.WithLimits(&Limits{
+			CodeTimeout:     time.Minute,
+			CompileTimeout:  time.Minute,
+			ExecutionMemory: memory.Gigabyte * 4,
+		})
+
+		assert.Equal(t, time.Second*5, limited.CodeTimeout)
+		assert.Equal(t, time.Second*20, limited.CompileTimeout)
+		assert.Equal(t, memory.Gigabyte, limited.ExecutionMemory)
+	})
+}
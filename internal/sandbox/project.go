@@ -0,0 +1,104 @@
+package sandbox
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// ProjectDirectory is the directory within the sandbox the files of a
+// multi-file submission are written into, keeping them apart from the input
+// and output files of the runner.
+const ProjectDirectory = "project"
+
+// The limits of the paths of files within a multi-file submission.
+const (
+	maxSourcePathLength = 255
+	maxSourcePathDepth  = 10
+)
+
+// sourcePathSegment is a single directory or file name of a source path,
+// hidden files and relative segments such as ".." are not allowed.
+var sourcePathSegment = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9._-]*$`)
+
+type SourceFile struct {
+	// The path of the file relative to the project directory, e.g.
+	// "utils/math.c".
+	Path    string
+	Content string
+}
+
+// ProjectFileName returns the name the file at the given path is stored as
+// alongside the other files of the execution.
+func ProjectFileName(sourcePath string) string {
+	return path.Join(ProjectDirectory, sourcePath)
+}
+
+// ValidateSourcePath ensures the path is a clean relative path which stays
+// within the project directory.
+func ValidateSourcePath(sourcePath string) error {
+	if sourcePath == "" {
+		return fmt.Errorf("path is required")
+	}
+
+	if len(sourcePath) > maxSourcePathLength {
+		return fmt.Errorf("path exceeds the maximum length of %d", maxSourcePathLength)
+	}
+
+	if path.IsAbs(sourcePath) || path.Clean(sourcePath) != sourcePath {
+		return fmt.Errorf("path must be a clean relative path")
+	}
+
+	segments := strings.Split(sourcePath, "/")
+
+	if len(segments) > maxSourcePathDepth {
+		return fmt.Errorf("path exceeds the maximum depth of %d", maxSourcePathDepth)
+	}
+
+	for _, segment := range segments {
+		if !sourcePathSegment.MatchString(segment) {
+			return fmt.Errorf("path segment %q must only contain letters, digits, '.', '_' or '-'", segment)
+		}
+	}
+
+	return nil
+}
+
+// SupportsProjects returns true if the language can compile and run
+// multi-file submissions.
+func (l *LanguageCompiler) SupportsProjects() bool {
+	return l.projectRunSteps != ""
+}
+
+// ValidateEntryFile ensures the entry file can be used as the entry of a
+// multi-file submission of the language.
+func (l *LanguageCompiler) ValidateEntryFile(entryFile string) error {
+	if extension := path.Ext(l.SourceFile); path.Ext(entryFile) != extension {
+		return fmt.Errorf("entry file must have the %s extension", extension)
+	}
+
+	return nil
+}
+
+// ProjectFiles returns the absolute paths within the sandbox of every project
+// file with the given extension separated by a space, used within the
+// compile steps to compile every submitted file.
+func (e ExecutionParameters) ProjectFiles(extension string) string {
+	files := make([]string, 0, len(e.SourceFiles))
+
+	for _, sourceFile := range e.SourceFiles {
+		if path.Ext(sourceFile) == extension {
+			files = append(files, path.Join("/input", ProjectDirectory, sourceFile))
+		}
+	}
+
+	return strings.Join(files, " ")
+}
+
+// EntryClass returns the fully qualified class name of the entry file for
+// languages running a class, e.g. "com/example/Main.java" becomes
+// "com.example.Main".
+func (e ExecutionParameters) EntryClass() string {
+	return strings.ReplaceAll(strings.TrimSuffix(e.EntryFile, path.Ext(e.EntryFile)), "/", ".")
+}
@@ -0,0 +1,118 @@
+package sandbox
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSourcePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		wantErr string
+	}{{
+		name: "should allow a file",
+		path: "solution.c",
+	}, {
+		name: "should allow a nested file",
+		path: "com/example/Main.java",
+	}, {
+		name:    "should reject an empty path",
+		path:    "",
+		wantErr: "path is required",
+	}, {
+		name:    "should reject an absolute path",
+		path:    "/etc/passwd",
+		wantErr: "path must be a clean relative path",
+	}, {
+		name:    "should reject a path leaving the project directory",
+		path:    "../runner.json",
+		wantErr: `path segment ".." must only contain letters, digits, '.', '_' or '-'`,
+	}, {
+		name:    "should reject a path which is not clean",
+		path:    "utils/../solution.c",
+		wantErr: "path must be a clean relative path",
+	}, {
+		name:    "should reject a hidden file",
+		path:    "utils/.hidden",
+		wantErr: `path segment ".hidden" must only contain letters, digits, '.', '_' or '-'`,
+	}, {
+		name:    "should reject a path containing spaces",
+		path:    "my file.c",
+		wantErr: `path segment "my file.c" must only contain letters, digits, '.', '_' or '-'`,
+	}, {
+		name:    "should reject a path which is too deep",
+		path:    strings.Repeat("a/", 10) + "solution.c",
+		wantErr: "path exceeds the maximum depth of 10",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSourcePath(tt.path)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestLanguageCompilerValidateEntryFile(t *testing.T) {
+	assert.NoError(t, Compilers["c"].ValidateEntryFile("main.c"))
+	assert.EqualError(t, Compilers["c"].ValidateEntryFile("main.h"), "entry file must have the .c extension")
+}
+
+func TestProjectCompileSteps(t *testing.T) {
+	parameters := ExecutionParameters{
+		ID:          "id",
+		SourceFiles: []string{"main.c", "utils/math.c", "utils/math.h", "com/example/Main.java"},
+		EntryFile:   "com/example/Main.java",
+	}
+
+	tests := []struct {
+		name string
+		step string
+		want string
+	}{{
+		name: "should compile every file of the extension",
+		step: Compilers["c"].projectCompileSteps[0],
+		want: "gcc -g -O2 -std=gnu11 -static -I /input/project -o /solution /input/project/main.c /input/project/utils/math.c -lm",
+	}, {
+		name: "should run the entry class",
+		step: Compilers["java"].projectRunSteps,
+		want: "java -Xmx2048m -cp /input/project com.example.Main",
+	}, {
+		name: "should run the entry file",
+		step: Compilers["python"].projectRunSteps,
+		want: "pypy /input/project/com/example/Main.java",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the steps are parsed the same as within the runner.
+			tmpl, err := template.New(parameters.ID).Parse(tt.step)
+			assert.NoError(t, err)
+
+			result := strings.Builder{}
+			assert.NoError(t, tmpl.Execute(&result, &parameters))
+			assert.Equal(t, tt.want, result.String())
+		})
+	}
+}
+
+func TestCompilersSupportProjects(t *testing.T) {
+	for name, compiler := range Compilers {
+		if name == "fsharp" {
+			assert.False(t, compiler.SupportsProjects(), name)
+			continue
+		}
+
+		assert.True(t, compiler.SupportsProjects(), name)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -31,6 +32,10 @@ const (
 	TestNotRan
 	TestFailed
 	TestPassed
+
+	// TestPartial - The checker of the test accepted the output with a score
+	// lower than the full score of the test.
+	TestPartial
 )
 
 const (
@@ -50,8 +55,39 @@ const (
 	CompilationFailed
 	RunTimeError
 	NonDeterministicError
+
+	// Cancelled - The execution was cancelled by the consumer before it could
+	// complete, either while still queued or while the container was running.
+	Cancelled
 )
 
+// IsTerminal returns true if the status is a final state of the execution,
+// once reached the status will no longer transition.
+func (i ContainerStatus) IsTerminal() bool {
+	switch i {
+	case Killed, Finished, MemoryConstraintExceeded, TimeLimitExceeded,
+		ProvidedTestFailed, CompilationFailed, RunTimeError, NonDeterministicError,
+		Cancelled:
+		return true
+	case NotRan, Created, Running, Killing:
+		return false
+	}
+
+	return false
+}
+
+// ParseContainerStatus returns the container status for the given string
+// representation, the same value produced by String().
+func ParseContainerStatus(value string) (ContainerStatus, bool) {
+	for status := NotRan; status <= Cancelled; status++ {
+		if status.String() == value {
+			return status, true
+		}
+	}
+
+	return NotRan, false
+}
+
 type Test struct {
 	// The internal id of the test, this will be used to ensure that when the response comes
 	// through that there is a related id to match it up with th request.
@@ -64,6 +100,9 @@ type Test struct {
 	// the data has been returned. This is what we are going to ensure the given test case matches
 	// before providing a result.
 	ExpectedStdoutData []string
+	// The comparator used to determine if the standard output matches the
+	// expected standard output, if not set the output must match exactly.
+	Comparator *Comparator
 }
 
 type Request struct {
@@ -84,15 +123,37 @@ type Request struct {
 	// The source code that will be executed, this is the code that will be
 	// written to the path and mounted to the docker container.
 	SourceCode string
+	// The files of a multi-file submission, when provided these are written
+	// into the project directory and compiled together instead of the
+	// source code.
+	Files []*SourceFile
+	// The file of the multi-file submission containing the entry point of
+	// the program, relative to the project directory.
+	EntryFile string
+	// If the compiled binary of the code should be collected as an artifact
+	// alongside the files written to the artifacts directory.
+	IncludeBinary bool
+	// If the diff of the output of a failed test should include the unified
+	// diff of the expected and the actual output, alongside the first
+	// mismatch.
+	IncludeUnifiedDiff bool
 	// The reference details of the compilerName that will be running the code.
 	// Including details of the language, compilerName name (or interrupter)
 	// and the name of the given output file.
 	Compiler *LanguageCompiler
-	// The related test that will be executed with the sandbox, comparing a
-	// given input with a given output. This is an optional part since the
-	// process could just be completing the code and not actually testing
-	// anything.
-	Test *Test
+	// The related tests that will be executed with the sandbox, comparing a
+	// given input with a given output. The code is compiled once and then
+	// executed once per test. This is an optional part since the process
+	// could just be completing the code and not actually testing anything.
+	Tests []*Test
+	// The checks the code is executed for when running a checker, one run per
+	// check with the files of the check passed as the arguments. Used instead
+	// of the tests.
+	Checks []*Check
+	// The interactor running alongside the code for each test, connected to
+	// the standard input and output of the code instead of the input of the
+	// test. The interactor decides the verdict of each test.
+	Interactor *Interactor
 }
 
 type ExecutionParameters struct {
@@ -102,18 +163,86 @@ type ExecutionParameters struct {
 	Language        string        `json:"language"`
 	Run             string        `json:"run"`
 	RunTimeout      time.Duration `json:"runTimeout"`
-	StandardInput   string        `json:"standardInput"`
+	StandardInputs  []string      `json:"standardInputs"`
 	ExecutionMemory memory.Memory `json:"executionMemory"`
+	SourceFiles     []string      `json:"sourceFiles"`
+	EntryFile       string        `json:"entryFile"`
+
+	// The limits of the files collected from the artifacts directory and the
+	// compiled binary copied into the artifacts directory, if requested.
+	ArtifactsMaxBytes int64  `json:"artifactsMaxBytes"`
+	ArtifactsMaxFiles int    `json:"artifactsMaxFiles"`
+	BinaryFile        string `json:"binaryFile"`
+
+	// The additional arguments of each run, appended to the run command.
+	RunArguments [][]string `json:"runArguments"`
+
+	// The interactor running alongside each run, if the code is interactive.
+	Interactor *InteractorParameters `json:"interactor"`
 }
 
-type ExecutionResponse struct {
-	CompileTime        int64           `json:"compileTime"`
-	CompilerOutput     []string        `json:"compilerOutput"`
+type ExecutionRunResponse struct {
 	Output             []string        `json:"output"`
 	OutputErr          []string        `json:"output_error"`
 	Runtime            int64           `json:"runTime"`
 	RuntimeMemoryBytes int64           `json:"runtimeMemory"`
 	Status             ContainerStatus `json:"status"`
+	ExitCode           int             `json:"exitCode"`
+
+	// The results of the interactor running alongside the code, if the code
+	// is interactive.
+	InteractorOutputErr          []string        `json:"interactorOutputError"`
+	InteractorRuntime            int64           `json:"interactorRunTime"`
+	InteractorRuntimeMemoryBytes int64           `json:"interactorRuntimeMemory"`
+	InteractorStatus             ContainerStatus `json:"interactorStatus"`
+	InteractorExitCode           int             `json:"interactorExitCode"`
+}
+
+type ExecutionResponse struct {
+	CompileTime    int64                   `json:"compileTime"`
+	CompilerOutput []string                `json:"compilerOutput"`
+	Runs           []*ExecutionRunResponse `json:"runs"`
+	Status         ContainerStatus         `json:"status"`
+	Artifacts      []*Artifact             `json:"artifacts"`
+}
+
+type TestCaseResponse struct {
+	// The raw output written into the stdout for the test case.
+	Output []string
+
+	// The raw output written into the stderr for the test case.
+	OutputError []string
+
+	// The given status of the test case execution.
+	Status ContainerStatus
+
+	// The complete runtime of the test case.
+	Runtime time.Duration
+
+	// The total memory used during the runtime of the test case.
+	RuntimeMemory memory.Memory
+
+	// The result for the test case if it was provided.
+	TestStatus ContainerTestStatus
+
+	// The exit code of the code, -1 if the code was killed.
+	ExitCode int
+
+	// The score of the test case between 0 and 1, the full score if the test
+	// passed or the score given by the checker.
+	Score float64
+
+	// The message of the checker or the interactor judging the output of the
+	// test case.
+	CheckerMessage string
+
+	// The complete runtime and the total memory used by the interactor
+	// running alongside the test case, if the code is interactive.
+	InteractorRuntime       time.Duration
+	InteractorRuntimeMemory memory.Memory
+
+	// The diff of the output and the expected output if the test failed.
+	Diff *OutputDiff
 }
 
 type Response struct {
@@ -140,8 +269,24 @@ type Response struct {
 	// The complete compile time of the container in milliseconds (if not interpreter)
 	CompileTime time.Duration
 
-	// The result for the test if it was provided.
+	// The aggregate result for all the tests if they were provided.
 	TestStatus ContainerTestStatus
+
+	// The individual results for each execution of the code, one per test
+	// or a single entry if no tests were provided.
+	TestCases []*TestCaseResponse
+
+	// The score of the groups of tests out of the maximum score, the sum of
+	// the weights of the groups. Only scored if groups were provided.
+	Score    float64
+	MaxScore float64
+
+	// The individual results of each group of tests, in the same order as the
+	// groups were provided.
+	Groups []*TestGroupResponse
+
+	// The files collected from the artifacts directory.
+	Artifacts []*ArtifactFile
 }
 
 type Container struct {
@@ -150,8 +295,13 @@ type Container struct {
 	events []*events.Message
 
 	executionResponse *ExecutionResponse
+	artifacts         []*ArtifactFile
 	complete          chan string
 
+	// the files are cleaned up either once the container has been removed or
+	// once the container has been killed, whichever happens first.
+	cleanupOnce sync.Once
+
 	client  *client.Client
 	request *Request
 }
@@ -191,48 +341,77 @@ func (d *Container) prepare(_ context.Context) error {
 	// input and then the location in which the compilerName will write the standard output and the
 	// standard error output. After the data is written and returned, the location will be
 	// deleted.
-	if err := os.MkdirAll(d.request.Path, 0o750); err != nil {
+	if err := os.MkdirAll(filepath.Join(d.request.Path, ArtifactsDirectory), 0o750); err != nil {
 		return errors.Wrap(err, "failed to make required directories")
 	}
 
-	sourceFilePath := filepath.Join(d.request.Path, d.request.Compiler.SourceFile)
+	compileSteps := d.request.Compiler.compileSteps
+	runSteps := d.request.Compiler.runSteps
+	var sourceFiles []string
 
-	// Go through the process of writing down the source file to disk, this will be used
-	// and read again when gathering the results.
-	sourceFile, sourceFileErr := os.Create(sourceFilePath)
+	if len(d.request.Files) > 0 {
+		for _, file := range d.request.Files {
+			if err := writeProjectFile(d.request.Path, file); err != nil {
+				return err
+			}
 
-	if sourceFileErr != nil {
-		return errors.Wrap(sourceFileErr, "failed to create source file")
-	}
+			sourceFiles = append(sourceFiles, file.Path)
+		}
 
-	defer func(sourceFile *os.File) {
-		_ = sourceFile.Close()
-	}(sourceFile)
+		compileSteps = d.request.Compiler.projectCompileSteps
+		runSteps = d.request.Compiler.projectRunSteps
+	} else {
+		sourceFilePath := filepath.Join(d.request.Path, d.request.Compiler.SourceFile)
 
-	if _, writeErr := sourceFile.WriteString(d.request.SourceCode + "\r\n"); writeErr != nil {
-		return errors.Wrap(writeErr, "failed to write source code")
+		// Go through the process of writing down the source file to disk, this will be used
+		// and read again when gathering the results.
+		if err := writeSourceFile(sourceFilePath, d.request.SourceCode); err != nil {
+			return err
+		}
 	}
 
-	inputFilePath := filepath.Join(d.request.Path, d.request.Compiler.InputFile)
+	// The code is always executed at least once, even without any tests
+	// which results in a single run with an empty input file.
+	runs := len(d.request.Tests)
 
-	// Go through the process of writing down the input file to disk, this will be used
-	// and read again when gathering the results.
-	inputFile, inputFileErr := os.Create(inputFilePath)
+	if len(d.request.Checks) > 0 {
+		runs = len(d.request.Checks)
+	}
 
-	if inputFileErr != nil {
-		return errors.Wrap(inputFileErr, "failed to create input file")
+	if runs == 0 {
+		runs = 1
 	}
 
-	defer func(inputFile *os.File) {
-		_ = inputFile.Close()
-	}(inputFile)
+	standardInputs := make([]string, 0, runs)
 
-	if d.request.Test != nil {
-		for _, s := range d.request.Test.StdinData {
-			if _, writeErr := fmt.Fprintf(inputFile, "%s\n", s); writeErr != nil {
-				return errors.Wrap(writeErr, "failed to write standard in data")
-			}
+	for i := 0; i < runs; i++ {
+		var stdinData []string
+
+		// the input of an interactive test is only given to the interactor,
+		// written alongside the other files of the interactor.
+		if i < len(d.request.Tests) && d.request.Interactor == nil {
+			stdinData = d.request.Tests[i].StdinData
 		}
+
+		inputFileName := d.request.Compiler.testInputFile(i)
+
+		if err := writeInputFile(filepath.Join(d.request.Path, inputFileName), stdinData); err != nil {
+			return err
+		}
+
+		standardInputs = append(standardInputs, inputFileName)
+	}
+
+	runArguments, err := writeCheckFiles(d.request.Path, d.request.Checks)
+
+	if err != nil {
+		return err
+	}
+
+	interactor, err := writeInteractorFiles(d.request.Path, d.request.Interactor, d.request.Tests, runs)
+
+	if err != nil {
+		return err
 	}
 
 	runnerConfig := filepath.Join(d.request.Path, "runner.json")
@@ -242,10 +421,22 @@ func (d *Container) prepare(_ context.Context) error {
 		Language:        d.request.Compiler.Language,
 		RunTimeout:      d.request.ExecutionProfile.CodeTimeout,
 		CompileTimeout:  d.request.ExecutionProfile.CompileTimeout,
-		StandardInput:   d.request.Compiler.InputFile,
-		CompileSteps:    d.request.Compiler.compileSteps,
-		Run:             d.request.Compiler.runSteps,
+		StandardInputs:  standardInputs,
+		CompileSteps:    compileSteps,
+		Run:             runSteps,
 		ExecutionMemory: d.request.ExecutionProfile.ExecutionMemory,
+		SourceFiles:     sourceFiles,
+		EntryFile:       d.request.EntryFile,
+
+		ArtifactsMaxBytes: d.request.ExecutionProfile.MaxArtifactsSize.Bytes(),
+		ArtifactsMaxFiles: d.request.ExecutionProfile.MaxArtifacts,
+
+		RunArguments: runArguments,
+		Interactor:   interactor,
+	}
+
+	if d.request.IncludeBinary {
+		parameters.BinaryFile = d.request.Compiler.binaryFile
 	}
 
 	runnerFile, runnerError := os.Create(runnerConfig)
@@ -265,6 +456,64 @@ func (d *Container) prepare(_ context.Context) error {
 
 }
 
+// writeSourceFile writes down the source code to the given path, this will be
+// used and read again when gathering the results.
+func writeSourceFile(path string, sourceCode string) error {
+	sourceFile, sourceFileErr := os.Create(path)
+
+	if sourceFileErr != nil {
+		return errors.Wrap(sourceFileErr, "failed to create source file")
+	}
+
+	defer func(sourceFile *os.File) {
+		_ = sourceFile.Close()
+	}(sourceFile)
+
+	if _, writeErr := sourceFile.WriteString(sourceCode + "\r\n"); writeErr != nil {
+		return errors.Wrap(writeErr, "failed to write source code")
+	}
+
+	return nil
+}
+
+// writeProjectFile writes down the file of a multi-file submission into the
+// project directory, creating any of the directories of its path.
+func writeProjectFile(root string, file *SourceFile) error {
+	if err := ValidateSourcePath(file.Path); err != nil {
+		return errors.Wrapf(err, "invalid project file %q", file.Path)
+	}
+
+	path := filepath.Join(root, ProjectDirectory, filepath.FromSlash(file.Path))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return errors.Wrap(err, "failed to make project directories")
+	}
+
+	return writeSourceFile(path, file.Content)
+}
+
+// writeInputFile writes down the standard input data to the given path, each
+// item of the data is written as its own line.
+func writeInputFile(path string, stdinData []string) error {
+	inputFile, inputFileErr := os.Create(path)
+
+	if inputFileErr != nil {
+		return errors.Wrap(inputFileErr, "failed to create input file")
+	}
+
+	defer func(inputFile *os.File) {
+		_ = inputFile.Close()
+	}(inputFile)
+
+	for _, s := range stdinData {
+		if _, writeErr := fmt.Fprintf(inputFile, "%s\n", s); writeErr != nil {
+			return errors.Wrap(writeErr, "failed to write standard in data")
+		}
+	}
+
+	return nil
+}
+
 // execute the sandbox environment, building up the arguments, creating the container and starting
 // it. Everything after this point will be based on the stream of data being produced by the
 // docker stream.
@@ -312,17 +561,20 @@ func (d *Container) execute(ctx context.Context) error {
 	return nil
 }
 
-// cleanup will remove all the files related to this container on call.
-func (d *Container) cleanup() error {
-	close(d.complete)
+// cleanup will remove all the files related to this container on call, only
+// the first call has any effect.
+func (d *Container) cleanup() (err error) {
+	d.cleanupOnce.Do(func() {
+		close(d.complete)
 
-	if d.request.Path != "" {
-		if removeErr := os.RemoveAll(d.request.Path); removeErr != nil {
-			return errors.Wrap(removeErr, "failed to clean up temp directory")
+		if d.request.Path != "" {
+			if removeErr := os.RemoveAll(d.request.Path); removeErr != nil {
+				err = errors.Wrap(removeErr, "failed to clean up temp directory")
+			}
 		}
-	}
+	})
 
-	return nil
+	return err
 }
 
 func (d *Container) getSandboxRunnerOutput() (*ExecutionResponse, error) {
@@ -401,41 +653,145 @@ func (d *Container) handleContainerRemoved() {
 	output, _ := d.getSandboxRunnerOutput()
 	d.executionResponse = output
 
-}
+	// the artifacts must be read before the directory is cleaned up, once
+	// the response has been requested there is nothing left to read.
+	if output != nil && len(output.Artifacts) > 0 {
+		artifacts, err := readArtifacts(filepath.Join(d.request.Path, ArtifactsDirectory), output.Artifacts,
+			d.request.ExecutionProfile.MaxArtifactsSize.Bytes(), d.request.ExecutionProfile.MaxArtifacts)
 
-// GetResponse - Get the response of the sandbox, can only be called once in removed state.
-func (d *Container) GetResponse() *Response {
-	testStatus := NoTest
-
-	// the test is specified and the status is finished so lets go and verify
-	// that the test passed by verifying the out content with the expected content.
-	if d.status == Finished && d.request.Test != nil {
-		testStatus = TestPassed
-
-		if len(d.executionResponse.Output) != len(d.request.Test.ExpectedStdoutData) {
-			testStatus = TestFailed
-		} else {
-			for i, expectedData := range d.request.Test.ExpectedStdoutData {
-				if d.executionResponse.Output[i] != expectedData {
-					testStatus = TestFailed
-					break
-				}
-			}
+		if err != nil {
+			log.Warn().Err(err).Str("requestID", d.request.ID).Msg("failed to read all artifacts")
 		}
+
+		d.artifacts = artifacts
 	}
+}
 
+// GetResponse - Get the response of the sandbox, can only be called once in removed state.
+func (d *Container) GetResponse() *Response {
 	if d.executionResponse == nil {
 		d.executionResponse = &ExecutionResponse{}
 	}
 
-	return &Response{
+	resp := &Response{
 		CompilerOutput: d.executionResponse.CompilerOutput,
-		Output:         d.executionResponse.Output,
-		OutputError:    d.executionResponse.OutputErr,
 		Status:         d.executionResponse.Status,
-		TestStatus:     testStatus,
-		Runtime:        time.Duration(d.executionResponse.Runtime) * time.Nanosecond,
+		TestStatus:     NoTest,
 		CompileTime:    time.Duration(d.executionResponse.CompileTime) * time.Nanosecond,
-		RuntimeMemory:  memory.Memory(d.executionResponse.RuntimeMemoryBytes),
+		TestCases:      make([]*TestCaseResponse, 0, len(d.executionResponse.Runs)),
+		Artifacts:      d.artifacts,
+	}
+
+	for i, run := range d.executionResponse.Runs {
+		var test *Test
+
+		if i < len(d.request.Tests) {
+			test = d.request.Tests[i]
+		}
+
+		testCase := &TestCaseResponse{
+			Output:        run.Output,
+			OutputError:   run.OutputErr,
+			Status:        run.Status,
+			Runtime:       time.Duration(run.Runtime) * time.Nanosecond,
+			RuntimeMemory: memory.Memory(run.RuntimeMemoryBytes),
+			TestStatus:    getTestStatus(run, test),
+			ExitCode:      run.ExitCode,
+
+			InteractorRuntime:       time.Duration(run.InteractorRuntime) * time.Nanosecond,
+			InteractorRuntimeMemory: memory.Memory(run.InteractorRuntimeMemoryBytes),
+		}
+
+		if testCase.TestStatus == TestPassed {
+			testCase.Score = 1
+		}
+
+		if testCase.TestStatus == TestFailed && d.request.Interactor == nil {
+			testCase.Diff = NewOutputDiff(test.Comparator, run.Output, test.ExpectedStdoutData, d.request.IncludeUnifiedDiff)
+		}
+
+		// the output of interactive code is never compared, the verdict is
+		// decided by the interactor instead.
+		if d.request.Interactor != nil && testCase.TestStatus != NoTest && run.Status == Finished {
+			result := ParseInteractorResult(run)
+
+			testCase.TestStatus = result.TestStatus
+			testCase.Score = result.Score
+			testCase.CheckerMessage = result.Message
+		}
+
+		resp.TestCases = append(resp.TestCases, testCase)
+	}
+
+	aggregateTestCaseResponses(resp, len(d.request.Tests) > 0)
+	return resp
+}
+
+// getTestStatus determines the test status of a single run by verifying the
+// output content with the expected content of the test.
+func getTestStatus(run *ExecutionRunResponse, test *Test) ContainerTestStatus {
+	if test == nil {
+		return NoTest
+	}
+
+	if run.Status != Finished {
+		return TestNotRan
+	}
+
+	if !test.Comparator.Compare(run.Output, test.ExpectedStdoutData) {
+		return TestFailed
+	}
+
+	return TestPassed
+}
+
+// aggregateTestCaseResponses fills the overall status, test status, runtime and
+// memory of the response from the individual test cases. The output of the
+// response is the output of the first test case that did not pass, otherwise
+// the first test case.
+func aggregateTestCaseResponses(resp *Response, hasTests bool) {
+	switch {
+	case hasTests && len(resp.TestCases) == 0:
+		resp.TestStatus = TestNotRan
+	case hasTests:
+		resp.TestStatus = TestPassed
+	}
+
+	var outputCase *TestCaseResponse
+
+	for _, testCase := range resp.TestCases {
+		if testCase.Runtime > resp.Runtime {
+			resp.Runtime = testCase.Runtime
+		}
+
+		if testCase.RuntimeMemory > resp.RuntimeMemory {
+			resp.RuntimeMemory = testCase.RuntimeMemory
+		}
+
+		if resp.Status == Finished && testCase.Status != Finished {
+			resp.Status = testCase.Status
+		}
+
+		switch {
+		case testCase.TestStatus == TestFailed:
+			resp.TestStatus = TestFailed
+		case testCase.TestStatus == TestNotRan && (resp.TestStatus == TestPassed || resp.TestStatus == TestPartial):
+			resp.TestStatus = TestNotRan
+		case testCase.TestStatus == TestPartial && resp.TestStatus == TestPassed:
+			resp.TestStatus = TestPartial
+		}
+
+		if outputCase == nil && (testCase.Status != Finished || testCase.TestStatus == TestFailed) {
+			outputCase = testCase
+		}
+	}
+
+	if outputCase == nil && len(resp.TestCases) > 0 {
+		outputCase = resp.TestCases[0]
+	}
+
+	if outputCase != nil {
+		resp.Output = outputCase.Output
+		resp.OutputError = outputCase.OutputError
 	}
 }
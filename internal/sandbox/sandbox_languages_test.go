@@ -64,7 +64,7 @@ func TestCompleteBasicExecution(t *testing.T) {
 				Compiler:   compiler,
 
 				// No tests are checked in this example.
-				Test: nil,
+				Tests: nil,
 			}
 
 			id, complete, err := manager.AddContainer(context.Background(), &request)
@@ -118,7 +118,7 @@ func TestCompleteMultiFunctionExecution(t *testing.T) {
 				Compiler:   compiler,
 
 				// No tests are checked in this example.
-				Test: nil,
+				Tests: nil,
 			}
 
 			id, complete, err := manager.AddContainer(context.Background(), &request)
@@ -180,7 +180,7 @@ func TestCompleteTimeBoundExecution(t *testing.T) {
 				Compiler:   compiler,
 
 				// No tests are checked in this example.
-				Test: nil,
+				Tests: nil,
 			}
 
 			id, complete, err := manager.AddContainer(context.Background(), &request)
@@ -236,7 +236,7 @@ func TestCompleteMemoryBoundExecution(t *testing.T) {
 				Compiler:   compiler,
 
 				// No tests are checked in this example.
-				Test: nil,
+				Tests: nil,
 			}
 
 			id, complete, err := manager.AddContainer(context.Background(), &request)
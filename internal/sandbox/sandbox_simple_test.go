@@ -99,11 +99,11 @@ func (s *SimpleSandboxSuite) SetupTest() {
 		Path:             filepath.Join(os.TempDir(), "executions", "raw", s.id.String()),
 		SourceCode:       mustGetCompilerTestTemplateByLanguage(s.T(), "simple", "python"),
 		Compiler:         mustGetCompilerByLanguage("python"),
-		Test: &Test{
+		Tests: []*Test{{
 			ID:                 s.id.String(),
 			StdinData:          []string{"first line", "second line"},
 			ExpectedStdoutData: []string{"third line", "fourth line"},
-		},
+		}},
 	}
 
 	s.container = NewSandboxContainer(&s.request, s.manager.dockerClient)
@@ -144,7 +144,7 @@ func (s *SimpleSandboxSuite) TestContainerPrepare() {
 	s.Run("should create input file with its contents", func() {
 		s.NoError(s.container.prepare(s.ctx))
 
-		inputFile := filepath.Join(s.request.Path, s.request.Compiler.InputFile)
+		inputFile := filepath.Join(s.request.Path, s.request.Compiler.testInputFile(0))
 		stats, err := os.Stat(inputFile)
 
 		s.NoError(err)
@@ -155,10 +155,10 @@ func (s *SimpleSandboxSuite) TestContainerPrepare() {
 
 		actual := ""
 
-		for i, testData := range s.request.Test.StdinData {
+		for i, testData := range s.request.Tests[0].StdinData {
 			actual += testData
 
-			if i != len(s.request.Test.StdinData)-1 {
+			if i != len(s.request.Tests[0].StdinData)-1 {
 				actual += "\n"
 			}
 		}
@@ -167,11 +167,11 @@ func (s *SimpleSandboxSuite) TestContainerPrepare() {
 	})
 
 	s.Run("should create input file with no contents with no test", func() {
-		s.request.Test = nil
+		s.request.Tests = nil
 
 		s.NoError(s.container.prepare(s.ctx))
 
-		inputFile := filepath.Join(s.request.Path, s.request.Compiler.InputFile)
+		inputFile := filepath.Join(s.request.Path, s.request.Compiler.testInputFile(0))
 		stats, err := os.Stat(inputFile)
 
 		s.NoError(err)
@@ -200,10 +200,13 @@ func (s *SimpleSandboxSuite) TestContainerPrepare() {
 			Language:        s.request.Compiler.Language,
 			RunTimeout:      s.request.ExecutionProfile.CodeTimeout,
 			CompileTimeout:  s.request.ExecutionProfile.CompileTimeout,
-			StandardInput:   s.request.Compiler.InputFile,
+			StandardInputs:  []string{s.request.Compiler.testInputFile(0)},
 			CompileSteps:    s.request.Compiler.compileSteps,
 			Run:             s.request.Compiler.runSteps,
 			ExecutionMemory: s.request.ExecutionProfile.ExecutionMemory,
+
+			ArtifactsMaxBytes: s.request.ExecutionProfile.MaxArtifactsSize.Bytes(),
+			ArtifactsMaxFiles: s.request.ExecutionProfile.MaxArtifacts,
 		}
 
 		var runner ExecutionParameters
@@ -213,6 +216,74 @@ func (s *SimpleSandboxSuite) TestContainerPrepare() {
 	})
 }
 
+func (s *SimpleSandboxSuite) TestContainerGetResponse() {
+	s.Run("should return a verdict per test case", func() {
+		s.request.Tests = []*Test{{
+			StdinData:          []string{"1"},
+			ExpectedStdoutData: []string{"one"},
+		}, {
+			StdinData:          []string{"2"},
+			ExpectedStdoutData: []string{"two"},
+		}}
+
+		s.container.executionResponse = &ExecutionResponse{
+			Status: Finished,
+			Runs: []*ExecutionRunResponse{
+				{Output: []string{"one"}, Status: Finished, Runtime: 10, RuntimeMemoryBytes: 20},
+				{Output: []string{"three"}, Status: Finished, Runtime: 30, RuntimeMemoryBytes: 10},
+			},
+		}
+
+		resp := s.container.GetResponse()
+
+		s.Len(resp.TestCases, 2)
+		s.Equal(TestPassed, resp.TestCases[0].TestStatus)
+		s.Equal(TestFailed, resp.TestCases[1].TestStatus)
+
+		s.Equal(Finished, resp.Status)
+		s.Equal(TestFailed, resp.TestStatus)
+		s.Equal([]string{"three"}, resp.Output)
+		s.EqualValues(30, resp.Runtime)
+		s.EqualValues(20, resp.RuntimeMemory)
+	})
+
+	s.Run("should take the status of the first test case that did not finish", func() {
+		s.request.Tests = []*Test{{ExpectedStdoutData: []string{"one"}}, {ExpectedStdoutData: []string{"two"}}}
+
+		s.container.executionResponse = &ExecutionResponse{
+			Status: Finished,
+			Runs: []*ExecutionRunResponse{
+				{Output: []string{"one"}, Status: Finished},
+				{Status: TimeLimitExceeded},
+			},
+		}
+
+		resp := s.container.GetResponse()
+
+		s.Equal(TimeLimitExceeded, resp.Status)
+		s.Equal(TestNotRan, resp.TestCases[1].TestStatus)
+		s.Equal(TestNotRan, resp.TestStatus)
+	})
+
+	s.Run("should pass when all test cases pass", func() {
+		s.request.Tests = []*Test{{ExpectedStdoutData: []string{"one"}}, {ExpectedStdoutData: []string{"two"}}}
+
+		s.container.executionResponse = &ExecutionResponse{
+			Status: Finished,
+			Runs: []*ExecutionRunResponse{
+				{Output: []string{"one"}, Status: Finished},
+				{Output: []string{"two"}, Status: Finished},
+			},
+		}
+
+		resp := s.container.GetResponse()
+
+		s.Equal(Finished, resp.Status)
+		s.Equal(TestPassed, resp.TestStatus)
+		s.Equal([]string{"one"}, resp.Output)
+	})
+}
+
 func TestSimpleSandboxTestSuite(t *testing.T) {
 	suite.Run(t, new(SimpleSandboxSuite))
 }
@@ -17,7 +17,7 @@ import (
 // mustGetCompilerTemplateByLanguage will return the language compiler template
 // for the given provided language or panic.
 func mustGetCompilerTemplateByLanguage(language string) string {
-	if template, ok := CompilerTemplate[strings.ToLower(language)]; ok {
+	if template, ok := GetTemplate(strings.ToLower(language), DefaultTemplateVariant); ok {
 		return template
 	}
 
@@ -51,11 +51,11 @@ func (s *SandboxSuite) SetupTest() {
 		Path:             filepath.Join(os.TempDir(), "executions", "raw", s.id.String()),
 		SourceCode:       mustGetCompilerTemplateByLanguage("python"),
 		Compiler:         mustGetCompilerByLanguage("python"),
-		Test: &Test{
+		Tests: []*Test{{
 			ID:                 s.id.String(),
 			StdinData:          []string{"first line", "second line"},
 			ExpectedStdoutData: []string{"third line", "fourth line"},
-		},
+		}},
 	}
 
 	s.container = NewSandboxContainer(&s.request, s.manager.dockerClient)
@@ -71,7 +71,7 @@ func (s *SandboxSuite) TestSimpleExecution() {
 	s.Run("container should run provided code snippet to completion", func() {
 		// remove the test, this is not going to perform this kind of testing
 		// but validating tests will be performed in another set of tests.
-		s.request.Test = nil
+		s.request.Tests = nil
 
 		go s.manager.Start(s.ctx)
 		defer s.manager.Stop()
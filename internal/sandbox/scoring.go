@@ -0,0 +1,144 @@
+package sandbox
+
+import (
+	"math"
+)
+
+type ScoringPolicy int
+
+const (
+	// ScoreAllOrNothing gives the full weight of the group only if every test
+	// of the group passed, otherwise nothing.
+	ScoreAllOrNothing ScoringPolicy = iota
+	// ScoreProportional gives the weight of the group in proportion to the
+	// average score of the tests of the group.
+	ScoreProportional
+	// ScoreMinimum gives the weight of the group in proportion to the lowest
+	// score of the tests of the group.
+	ScoreMinimum
+)
+
+// TestGroup is a named group of tests scored together, e.g. a subtask.
+type TestGroup struct {
+	Name string `json:"name"`
+
+	// The maximum score of the group, given when every test of the group
+	// scored fully.
+	Weight float64       `json:"weight"`
+	Policy ScoringPolicy `json:"policy"`
+
+	// The names of the groups which must score fully for this group to be
+	// scored, otherwise the group scores nothing. Dependencies are always
+	// groups declared before this group.
+	Dependencies []string `json:"dependencies"`
+
+	// The indexes of the tests of the group.
+	Tests []int `json:"tests"`
+}
+
+// TestGroupResponse is the result of a single group of tests.
+type TestGroupResponse struct {
+	Name string
+
+	// The score given to the group out of the weight of the group.
+	Score  float64
+	Weight float64
+
+	// The result of the group, passed if the group scored fully, failed if it
+	// scored nothing and partial otherwise. The group is not ran if none of
+	// its tests were executed, e.g. the code failed to compile, or if any of
+	// its dependencies did not score fully.
+	TestStatus ContainerTestStatus
+}
+
+// ScoreTestGroups scores each of the groups from the score of the test cases,
+// filling in the score, the maximum score and the groups of the response.
+func ScoreTestGroups(resp *Response, groups []*TestGroup) {
+	if len(groups) == 0 {
+		return
+	}
+
+	resp.Score, resp.MaxScore = 0, 0
+	resp.Groups = make([]*TestGroupResponse, 0, len(groups))
+
+	passed := make(map[string]bool, len(groups))
+
+	for _, group := range groups {
+		result := &TestGroupResponse{Name: group.Name, Weight: group.Weight, TestStatus: TestNotRan}
+		resp.MaxScore += group.Weight
+		resp.Groups = append(resp.Groups, result)
+
+		if !dependenciesPassed(group, passed) {
+			continue
+		}
+
+		score, executed := scoreTestGroup(group, resp.TestCases)
+
+		if !executed {
+			continue
+		}
+
+		switch {
+		case score >= 1:
+			result.TestStatus = TestPassed
+			passed[group.Name] = true
+		case score > 0:
+			result.TestStatus = TestPartial
+		default:
+			result.TestStatus = TestFailed
+		}
+
+		result.Score = score * group.Weight
+		resp.Score += result.Score
+	}
+}
+
+// dependenciesPassed returns true if every dependency of the group scored
+// fully.
+func dependenciesPassed(group *TestGroup, passed map[string]bool) bool {
+	for _, dependency := range group.Dependencies {
+		if !passed[dependency] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// scoreTestGroup returns the score of the group between 0 and 1 following the
+// policy of the group, and false if none of the tests of the group were
+// executed. A test that was not executed scores nothing.
+func scoreTestGroup(group *TestGroup, testCases []*TestCaseResponse) (float64, bool) {
+	if len(group.Tests) == 0 {
+		return 0, false
+	}
+
+	total, lowest := 0.0, 1.0
+	executed := false
+
+	for _, index := range group.Tests {
+		score := 0.0
+
+		if index < len(testCases) {
+			score = testCases[index].Score
+			executed = true
+		}
+
+		total += score
+		lowest = math.Min(lowest, score)
+	}
+
+	switch group.Policy {
+	case ScoreProportional:
+		return total / float64(len(group.Tests)), executed
+	case ScoreMinimum:
+		return lowest, executed
+	case ScoreAllOrNothing:
+	}
+
+	if lowest < 1 {
+		return 0, executed
+	}
+
+	return 1, executed
+}
@@ -0,0 +1,127 @@
+package sandbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreTestGroups(t *testing.T) {
+	newResponse := func(scores ...float64) *Response {
+		resp := &Response{TestCases: make([]*TestCaseResponse, 0, len(scores))}
+
+		for _, score := range scores {
+			resp.TestCases = append(resp.TestCases, &TestCaseResponse{Score: score})
+		}
+
+		return resp
+	}
+
+	tests := []struct {
+		name      string
+		scores    []float64
+		groups    []*TestGroup
+		want      []*TestGroupResponse
+		wantScore float64
+		wantMax   float64
+	}{{
+		name:   "should score all or nothing",
+		scores: []float64{1, 1, 1, 0},
+		groups: []*TestGroup{
+			{Name: "small", Weight: 30, Tests: []int{0, 1}},
+			{Name: "large", Weight: 70, Tests: []int{2, 3}},
+		},
+		want: []*TestGroupResponse{
+			{Name: "small", Score: 30, Weight: 30, TestStatus: TestPassed},
+			{Name: "large", Score: 0, Weight: 70, TestStatus: TestFailed},
+		},
+		wantScore: 30,
+		wantMax:   100,
+	}, {
+		name:   "should score in proportion to the average score",
+		scores: []float64{1, 0.5, 0, 0.5},
+		groups: []*TestGroup{{Name: "all", Weight: 100, Policy: ScoreProportional, Tests: []int{0, 1, 2, 3}}},
+		want: []*TestGroupResponse{
+			{Name: "all", Score: 50, Weight: 100, TestStatus: TestPartial},
+		},
+		wantScore: 50,
+		wantMax:   100,
+	}, {
+		name:   "should score in proportion to the lowest score",
+		scores: []float64{1, 0.25, 0.5},
+		groups: []*TestGroup{{Name: "all", Weight: 40, Policy: ScoreMinimum, Tests: []int{0, 1, 2}}},
+		want: []*TestGroupResponse{
+			{Name: "all", Score: 10, Weight: 40, TestStatus: TestPartial},
+		},
+		wantScore: 10,
+		wantMax:   40,
+	}, {
+		name:   "should not score groups depending on a group which did not score fully",
+		scores: []float64{0, 1, 1},
+		groups: []*TestGroup{
+			{Name: "a", Weight: 10, Tests: []int{0}},
+			{Name: "b", Weight: 20, Dependencies: []string{"a"}, Tests: []int{1}},
+			{Name: "c", Weight: 30, Dependencies: []string{"b"}, Tests: []int{2}},
+		},
+		want: []*TestGroupResponse{
+			{Name: "a", Weight: 10, TestStatus: TestFailed},
+			{Name: "b", Weight: 20, TestStatus: TestNotRan},
+			{Name: "c", Weight: 30, TestStatus: TestNotRan},
+		},
+		wantMax: 60,
+	}, {
+		name:   "should score groups whose dependencies scored fully",
+		scores: []float64{1, 1},
+		groups: []*TestGroup{
+			{Name: "a", Weight: 10, Tests: []int{0}},
+			{Name: "b", Weight: 20, Dependencies: []string{"a"}, Tests: []int{1}},
+		},
+		want: []*TestGroupResponse{
+			{Name: "a", Score: 10, Weight: 10, TestStatus: TestPassed},
+			{Name: "b", Score: 20, Weight: 20, TestStatus: TestPassed},
+		},
+		wantScore: 30,
+		wantMax:   30,
+	}, {
+		name:   "should not score tests without a result",
+		scores: []float64{1},
+		groups: []*TestGroup{{Name: "all", Weight: 10, Policy: ScoreProportional, Tests: []int{0, 1}}},
+		want: []*TestGroupResponse{
+			{Name: "all", Score: 5, Weight: 10, TestStatus: TestPartial},
+		},
+		wantScore: 5,
+		wantMax:   10,
+	}, {
+		name: "should not run groups when no tests were executed",
+		groups: []*TestGroup{
+			{Name: "a", Weight: 10, Tests: []int{0}},
+			{Name: "b", Weight: 20, Policy: ScoreProportional, Tests: []int{1, 2}},
+		},
+		want: []*TestGroupResponse{
+			{Name: "a", Weight: 10, TestStatus: TestNotRan},
+			{Name: "b", Weight: 20, TestStatus: TestNotRan},
+		},
+		wantMax: 30,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := newResponse(tt.scores...)
+
+			ScoreTestGroups(resp, tt.groups)
+
+			assert.Equal(t, tt.want, resp.Groups)
+			assert.Equal(t, tt.wantScore, resp.Score)
+			assert.Equal(t, tt.wantMax, resp.MaxScore)
+		})
+	}
+
+	t.Run("should not score without groups", func(t *testing.T) {
+		resp := newResponse(1)
+
+		ScoreTestGroups(resp, nil)
+
+		assert.Nil(t, resp.Groups)
+		assert.Zero(t, resp.MaxScore)
+	})
+}
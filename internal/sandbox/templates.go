@@ -0,0 +1,171 @@
+package sandbox
+
+import (
+	"embed"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultTemplateVariant is the variant of the template returned when no
+// variant has been requested.
+const DefaultTemplateVariant = "default"
+
+// templateVariantName is the allowed name of a template variant, e.g.
+// "fast-io".
+var templateVariantName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+//go:embed templates/*
+var content embed.FS
+
+// Template is a code snippet the user can start from for the language.
+type Template struct {
+	Language string
+	Variant  string
+	Content  string
+}
+
+// compilerTemplates holds the templates by the language and then the variant,
+// the data should be small so templates will be in memory always.
+var compilerTemplates = map[string]map[string]string{}
+
+var (
+	once          sync.Once
+	templatesLock sync.RWMutex
+)
+
+// LoadEmbeddedTemplateFiles loads the templates embedded within the binary,
+// these can be extended or replaced by loading a template directory.
+func LoadEmbeddedTemplateFiles() {
+	once.Do(func() {
+		embedded, _ := fs.Sub(content, "templates")
+
+		if err := loadTemplates(embedded); err != nil {
+			log.Error().Err(err).Msg("failed to load embedded templates")
+		}
+
+		for language := range Compilers {
+			if _, ok := GetTemplate(language, DefaultTemplateVariant); !ok {
+				log.Warn().Str("lang", language).Msg("language does not have a template")
+			}
+		}
+	})
+}
+
+// LoadTemplateDirectory loads the templates of the directory, replacing any
+// already loaded template of the same language and variant. The directory
+// uses the same layout as the embedded templates, the default template of a
+// language is <language>.txt and every variant <language>/<variant>.txt.
+func LoadTemplateDirectory(directory string) error {
+	if _, err := os.Stat(directory); err != nil {
+		return errors.Wrap(err, "failed to read template directory")
+	}
+
+	return loadTemplates(os.DirFS(directory))
+}
+
+// loadTemplates loads the templates of every supported language from the
+// file system, files of unsupported languages are ignored.
+func loadTemplates(fsys fs.FS) error {
+	loaded := map[string]map[string]string{}
+
+	for language := range Compilers {
+		loaded[language] = map[string]string{}
+
+		if data, err := fs.ReadFile(fsys, language+".txt"); err == nil {
+			loaded[language][DefaultTemplateVariant] = string(data)
+		}
+
+		variants, err := fs.Glob(fsys, path.Join(language, "*.txt"))
+
+		if err != nil {
+			return errors.Wrapf(err, "failed to list %s templates", language)
+		}
+
+		for _, variantPath := range variants {
+			variant := strings.TrimSuffix(path.Base(variantPath), ".txt")
+
+			if !templateVariantName.MatchString(variant) {
+				log.Warn().Str("lang", language).Str("variant", variant).Msg("skipping template with invalid variant name")
+				continue
+			}
+
+			data, err := fs.ReadFile(fsys, variantPath)
+
+			if err != nil {
+				return errors.Wrapf(err, "failed to read %s template", variantPath)
+			}
+
+			loaded[language][variant] = string(data)
+		}
+	}
+
+	templatesLock.Lock()
+	defer templatesLock.Unlock()
+
+	for language, variants := range loaded {
+		if compilerTemplates[language] == nil {
+			compilerTemplates[language] = map[string]string{}
+		}
+
+		for variant, template := range variants {
+			compilerTemplates[language][variant] = template
+		}
+	}
+
+	return nil
+}
+
+// GetTemplate returns the template of the language and variant, the default
+// variant is used if no variant is provided.
+func GetTemplate(language string, variant string) (string, bool) {
+	if variant == "" {
+		variant = DefaultTemplateVariant
+	}
+
+	templatesLock.RLock()
+	defer templatesLock.RUnlock()
+
+	template, ok := compilerTemplates[language][variant]
+	return template, ok
+}
+
+// ListTemplates returns every template ordered by the language and then the
+// variant, optionally only the templates of the given language.
+func ListTemplates(language string) []*Template {
+	templatesLock.RLock()
+	defer templatesLock.RUnlock()
+
+	templates := make([]*Template, 0)
+
+	for templateLanguage, variants := range compilerTemplates {
+		if language != "" && templateLanguage != language {
+			continue
+		}
+
+		for variant, template := range variants {
+			templates = append(templates, &Template{
+				Language: templateLanguage,
+				Variant:  variant,
+				Content:  template,
+			})
+		}
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		if templates[i].Language != templates[j].Language {
+			return templates[i].Language < templates[j].Language
+		}
+
+		return templates[i].Variant < templates[j].Variant
+	})
+
+	return templates
+}
@@ -0,0 +1,67 @@
+package sandbox
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadTemplates(t *testing.T) {
+	previous := compilerTemplates
+	compilerTemplates = map[string]map[string]string{}
+
+	t.Cleanup(func() {
+		compilerTemplates = previous
+	})
+
+	embedded := fstest.MapFS{
+		"python.txt":                 {Data: []byte("print(1)")},
+		"python/stdin-echo.txt":      {Data: []byte("print(input())")},
+		"haskell.txt":                {Data: []byte("main = print 1")},
+		"tests/simple/python.txt":    {Data: []byte("print(2)")},
+		"python/Invalid Variant.txt": {Data: []byte("print(3)")},
+	}
+
+	directory := fstest.MapFS{
+		"python.txt":        {Data: []byte("print('replaced')")},
+		"go/class-based.go": {Data: []byte("ignored")},
+		"go/fast-io.txt":    {Data: []byte("package main")},
+	}
+
+	assert.NoError(t, loadTemplates(embedded))
+	assert.NoError(t, loadTemplates(directory))
+
+	t.Run("should return the default variant", func(t *testing.T) {
+		template, ok := GetTemplate("python", "")
+
+		assert.True(t, ok)
+		assert.Equal(t, "print('replaced')", template)
+	})
+
+	t.Run("should return the requested variant", func(t *testing.T) {
+		template, ok := GetTemplate("python", "stdin-echo")
+
+		assert.True(t, ok)
+		assert.Equal(t, "print(input())", template)
+	})
+
+	t.Run("should not return a missing variant", func(t *testing.T) {
+		_, ok := GetTemplate("go", DefaultTemplateVariant)
+		assert.False(t, ok)
+	})
+
+	t.Run("should list every variant ordered by the language", func(t *testing.T) {
+		assert.Equal(t, []*Template{
+			{Language: "go", Variant: "fast-io", Content: "package main"},
+			{Language: "python", Variant: "default", Content: "print('replaced')"},
+			{Language: "python", Variant: "stdin-echo", Content: "print(input())"},
+		}, ListTemplates(""))
+	})
+
+	t.Run("should list the variants of the language", func(t *testing.T) {
+		assert.Equal(t, []*Template{
+			{Language: "go", Variant: "fast-io", Content: "package main"},
+		}, ListTemplates("go"))
+	})
+}
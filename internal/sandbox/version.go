@@ -0,0 +1,110 @@
+package sandbox
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// versionProbeTimeout is the maximum time the version command of a language
+// can take, including starting the container.
+const versionProbeTimeout = time.Second * 30
+
+// CompileCommands returns the commands used to compile the code, these can
+// contain placeholders such as {{.ID}} which are filled in by the runner.
+func (l *LanguageCompiler) CompileCommands() []string {
+	return l.compileSteps
+}
+
+// RunCommand returns the command used to run the code, this can contain
+// placeholders such as {{.ID}} which are filled in by the runner.
+func (l *LanguageCompiler) RunCommand() string {
+	return l.runSteps
+}
+
+// CheckLanguageImage ensures the image of the language has been built.
+func (s *ContainerManager) CheckLanguageImage(ctx context.Context, compiler *LanguageCompiler) error {
+	_, _, err := s.dockerClient.ImageInspectWithRaw(ctx, compiler.VirtualMachineName)
+	return errors.Wrapf(err, "failed to inspect image %s", compiler.VirtualMachineName)
+}
+
+// ProbeVersion runs the version command of the language within its image,
+// returning the first line of the output as the version of the toolchain.
+func (s *ContainerManager) ProbeVersion(ctx context.Context, compiler *LanguageCompiler) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, versionProbeTimeout)
+	defer cancel()
+
+	create, err := s.dockerClient.ContainerCreate(ctx,
+		&container.Config{
+			Entrypoint:      strings.Split(compiler.versionCommand, " "),
+			Image:           compiler.VirtualMachineName,
+			NetworkDisabled: true,
+		},
+		&container.HostConfig{},
+		nil,
+		nil,
+		"version-"+uuid.NewString(),
+	)
+
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create version container")
+	}
+
+	defer func() {
+		_ = s.dockerClient.ContainerRemove(context.Background(), create.ID, types.ContainerRemoveOptions{Force: true})
+	}()
+
+	if err := s.dockerClient.ContainerStart(ctx, create.ID, types.ContainerStartOptions{}); err != nil {
+		return "", errors.Wrap(err, "failed to start version container")
+	}
+
+	statusCh, errCh := s.dockerClient.ContainerWait(ctx, create.ID, container.WaitConditionNotRunning)
+
+	select {
+	case err := <-errCh:
+		return "", errors.Wrap(err, "failed to wait for version container")
+	case status := <-statusCh:
+		if status.StatusCode != 0 {
+			return "", errors.Errorf("version command exited with status %d", status.StatusCode)
+		}
+	}
+
+	logs, err := s.dockerClient.ContainerLogs(ctx, create.ID, types.ContainerLogsOptions{ShowStdout: true, ShowStderr: true})
+
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read version container logs")
+	}
+
+	defer logs.Close()
+
+	// some toolchains such as java write the version to the standard error
+	// output, so both outputs are read.
+	output := bytes.Buffer{}
+
+	if _, err := stdcopy.StdCopy(&output, &output, logs); err != nil {
+		return "", errors.Wrap(err, "failed to read version container logs")
+	}
+
+	return parseVersion(output.String()), nil
+}
+
+// parseVersion returns the first non-empty line of the output.
+func parseVersion(output string) string {
+	scanner := bufio.NewScanner(strings.NewReader(output))
+
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			return line
+		}
+	}
+
+	return ""
+}
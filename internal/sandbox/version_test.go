@@ -0,0 +1,39 @@
+package sandbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   string
+	}{{
+		name:   "should return the only line",
+		output: "go version go1.21.5 linux/amd64\n",
+		want:   "go version go1.21.5 linux/amd64",
+	}, {
+		name:   "should return the first non-empty line",
+		output: "\n  openjdk version \"17.0.2\" 2022-01-18  \nOpenJDK Runtime Environment (build 17.0.2+8-86)\n",
+		want:   "openjdk version \"17.0.2\" 2022-01-18",
+	}, {
+		name:   "should return nothing without output",
+		output: "",
+		want:   "",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseVersion(tt.output))
+		})
+	}
+}
+
+func TestCompilersHaveVersionCommands(t *testing.T) {
+	for name, compiler := range Compilers {
+		assert.NotEmpty(t, compiler.versionCommand, name)
+	}
+}
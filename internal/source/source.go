@@ -0,0 +1,190 @@
+// Package source extracts the files of uploaded sources, which are either a
+// single plain file or an archive of many files.
+package source
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"compile-and-run-sandbox/internal/sandbox"
+)
+
+type Format int
+
+const (
+	// Plain is a single file uploaded as is.
+	Plain Format = iota
+	// Zip is a zip archive of many files.
+	Zip
+	// TarGz is a gzip compressed tar archive of many files.
+	TarGz
+)
+
+var (
+	ErrTooLarge     = errors.New("source exceeds the maximum size")
+	ErrTooManyFiles = errors.New("source exceeds the maximum number of files")
+	ErrNoFiles      = errors.New("source does not contain any files")
+)
+
+// Limits are the limits of an uploaded source, these are applied to both the
+// uploaded bytes and the extracted files to protect against archives
+// expanding far beyond their compressed size.
+type Limits struct {
+	// MaxBytes is the maximum number of bytes of the upload and the maximum
+	// total number of bytes of the extracted files.
+	MaxBytes int64
+	// MaxFiles is the maximum number of files within the source.
+	MaxFiles int
+}
+
+type extractor struct {
+	limits *Limits
+	files  []*sandbox.SourceFile
+	paths  map[string]bool
+	size   int64
+}
+
+// Extract returns the files of the uploaded source. The name is the path of
+// the file when the source is a plain file. Every path is validated to stay
+// within the project directory, archives containing anything other than
+// regular files and directories are rejected.
+func Extract(format Format, name string, data []byte, limits *Limits) ([]*sandbox.SourceFile, error) {
+	if int64(len(data)) > limits.MaxBytes {
+		return nil, errors.Wrapf(ErrTooLarge, "upload of %d bytes exceeds %d bytes", len(data), limits.MaxBytes)
+	}
+
+	e := &extractor{limits: limits, paths: map[string]bool{}}
+
+	var err error
+
+	switch format {
+	case Plain:
+		err = e.add(name, bytes.NewReader(data))
+	case Zip:
+		err = e.extractZip(data)
+	case TarGz:
+		err = e.extractTarGz(data)
+	default:
+		err = errors.Errorf("unknown source format %d", format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(e.files) == 0 {
+		return nil, ErrNoFiles
+	}
+
+	return e.files, nil
+}
+
+func (e *extractor) extractZip(data []byte) error {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+
+	if err != nil {
+		return errors.Wrap(err, "failed to read zip archive")
+	}
+
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		if !file.Mode().IsRegular() {
+			return errors.Errorf("unsupported file type of %q", file.Name)
+		}
+
+		content, err := file.Open()
+
+		if err != nil {
+			return errors.Wrapf(err, "failed to open %q", file.Name)
+		}
+
+		err = e.add(file.Name, content)
+		_ = content.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *extractor) extractTarGz(data []byte) error {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+
+	if err != nil {
+		return errors.Wrap(err, "failed to read gzip archive")
+	}
+
+	defer gzipReader.Close()
+
+	reader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := reader.Next()
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return errors.Wrap(err, "failed to read tar archive")
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			continue
+		case tar.TypeReg:
+			if err := e.add(header.Name, reader); err != nil {
+				return err
+			}
+		default:
+			return errors.Errorf("unsupported file type of %q", header.Name)
+		}
+	}
+}
+
+// add reads the content of the file, ensuring the limits are not exceeded
+// even when the size reported by the archive is incorrect.
+func (e *extractor) add(name string, content io.Reader) error {
+	// archives created from the current directory prefix every path.
+	name = strings.TrimPrefix(name, "./")
+
+	if err := sandbox.ValidateSourcePath(name); err != nil {
+		return errors.Wrapf(err, "invalid file %q", name)
+	}
+
+	if e.paths[name] {
+		return errors.Errorf("duplicate file %q", name)
+	}
+
+	if len(e.files) >= e.limits.MaxFiles {
+		return errors.Wrapf(ErrTooManyFiles, "more than %d files", e.limits.MaxFiles)
+	}
+
+	remaining := e.limits.MaxBytes - e.size
+	data, err := io.ReadAll(io.LimitReader(content, remaining+1))
+
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %q", name)
+	}
+
+	if int64(len(data)) > remaining {
+		return errors.Wrapf(ErrTooLarge, "extracted files exceed %d bytes", e.limits.MaxBytes)
+	}
+
+	e.size += int64(len(data))
+	e.paths[name] = true
+	e.files = append(e.files, &sandbox.SourceFile{Path: name, Content: string(data)})
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package source
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"compile-and-run-sandbox/internal/sandbox"
+)
+
+type archiveFile struct {
+	name    string
+	content string
+}
+
+func createZip(t *testing.T, files ...archiveFile) []byte {
+	buffer := bytes.Buffer{}
+	writer := zip.NewWriter(&buffer)
+
+	for _, file := range files {
+		w, err := writer.Create(file.name)
+		assert.NoError(t, err)
+
+		_, err = w.Write([]byte(file.content))
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, writer.Close())
+	return buffer.Bytes()
+}
+
+func createTarGz(t *testing.T, files ...archiveFile) []byte {
+	buffer := bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(&buffer)
+	writer := tar.NewWriter(gzipWriter)
+
+	for _, file := range files {
+		header := &tar.Header{Name: file.name, Mode: 0o600, Size: int64(len(file.content)), Typeflag: tar.TypeReg}
+
+		if strings.HasSuffix(file.name, "/") {
+			header = &tar.Header{Name: file.name, Mode: 0o700, Typeflag: tar.TypeDir}
+		}
+
+		assert.NoError(t, writer.WriteHeader(header))
+
+		_, err := writer.Write([]byte(file.content))
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, writer.Close())
+	assert.NoError(t, gzipWriter.Close())
+
+	return buffer.Bytes()
+}
+
+func TestExtract(t *testing.T) {
+	limits := &Limits{MaxBytes: 512, MaxFiles: 2}
+
+	tests := []struct {
+		name      string
+		format    Format
+		path      string
+		data      func(t *testing.T) []byte
+		wantFiles []*sandbox.SourceFile
+		wantErr   error
+	}{{
+		name:   "should extract a plain file",
+		format: Plain,
+		path:   "solution.py",
+		data:   func(*testing.T) []byte { return []byte("print(1)") },
+		wantFiles: []*sandbox.SourceFile{
+			{Path: "solution.py", Content: "print(1)"},
+		},
+	}, {
+		name:   "should extract a zip archive",
+		format: Zip,
+		data: func(t *testing.T) []byte {
+			return createZip(t, archiveFile{"main.c", "int main() {}"}, archiveFile{"utils/math.h", "int add();"})
+		},
+		wantFiles: []*sandbox.SourceFile{
+			{Path: "main.c", Content: "int main() {}"},
+			{Path: "utils/math.h", Content: "int add();"},
+		},
+	}, {
+		name:   "should extract a tar.gz archive skipping directories",
+		format: TarGz,
+		data: func(t *testing.T) []byte {
+			return createTarGz(t, archiveFile{"./", ""}, archiveFile{"./utils/", ""}, archiveFile{"./utils/math.h", "int add();"})
+		},
+		wantFiles: []*sandbox.SourceFile{
+			{Path: "utils/math.h", Content: "int add();"},
+		},
+	}, {
+		name:    "should reject an upload exceeding the maximum size",
+		format:  Plain,
+		path:    "solution.py",
+		data:    func(*testing.T) []byte { return bytes.Repeat([]byte("a"), 513) },
+		wantErr: ErrTooLarge,
+	}, {
+		name:   "should reject extracted files exceeding the maximum size",
+		format: TarGz,
+		data: func(t *testing.T) []byte {
+			return createTarGz(t, archiveFile{"a.c", strings.Repeat("a", 300)}, archiveFile{"b.c", strings.Repeat("b", 300)})
+		},
+		wantErr: ErrTooLarge,
+	}, {
+		name:   "should reject archives exceeding the maximum number of files",
+		format: Zip,
+		data: func(t *testing.T) []byte {
+			return createZip(t, archiveFile{"a.c", "a"}, archiveFile{"b.c", "b"}, archiveFile{"c.c", "c"})
+		},
+		wantErr: ErrTooManyFiles,
+	}, {
+		name:    "should reject empty archives",
+		format:  Zip,
+		data:    func(t *testing.T) []byte { return createZip(t) },
+		wantErr: ErrNoFiles,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := Extract(tt.format, tt.path, tt.data(t), limits)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFiles, files)
+		})
+	}
+}
+
+func TestExtractInvalidArchives(t *testing.T) {
+	limits := &Limits{MaxBytes: 1024, MaxFiles: 10}
+
+	tests := []struct {
+		name    string
+		format  Format
+		data    []byte
+		wantErr string
+	}{{
+		name:    "should reject paths leaving the project directory",
+		format:  Zip,
+		data:    createZip(t, archiveFile{"../runner.json", "{}"}),
+		wantErr: `invalid file "../runner.json"`,
+	}, {
+		name:    "should reject duplicate paths",
+		format:  TarGz,
+		data:    createTarGz(t, archiveFile{"main.c", "a"}, archiveFile{"./main.c", "b"}),
+		wantErr: `duplicate file "main.c"`,
+	}, {
+		name:    "should reject corrupted archives",
+		format:  TarGz,
+		data:    []byte("not an archive"),
+		wantErr: "failed to read gzip archive",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Extract(tt.format, "", tt.data, limits)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
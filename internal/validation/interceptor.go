@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"context"
+
+	ut "github.com/go-playground/universal-translator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The validate interface of messages generated by protoc-gen-validate,
+// validating every field instead of stopping at the first invalid field.
+type validatorAll interface {
+	ValidateAll() error
+}
+
+// validate validates the request, returning an invalid argument error with a
+// violation for every invalid field.
+func validate(req interface{}, trans ut.Translator) error {
+	v, ok := req.(validatorAll)
+
+	if !ok {
+		return nil
+	}
+
+	err := v.ValidateAll()
+
+	if err == nil {
+		return nil
+	}
+
+	if validationErr, ok := FromError(err, trans); ok {
+		return validationErr
+	}
+
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// UnaryServerInterceptor returns a new unary server interceptor that validates
+// incoming messages, rejecting invalid messages with the field violations
+// before reaching the handler.
+func UnaryServerInterceptor(trans ut.Translator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validate(req, trans); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a new stream server interceptor that
+// validates every message received on the stream.
+func StreamServerInterceptor(trans ut.Translator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: stream, trans: trans})
+	}
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+	trans ut.Translator
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return validate(m, s.trans)
+}
@@ -1,9 +1,16 @@
 package validation
 
 import (
+	"fmt"
+	"strings"
+	"unicode"
+
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TranslateError(err error, trans ut.Translator) (errs []string) {
@@ -22,3 +29,185 @@ func TranslateError(err error, trans ut.Translator) (errs []string) {
 
 	return errs
 }
+
+// Violation is a single invalid field of a request.
+type Violation struct {
+	Field       string
+	Description string
+}
+
+// Error is a validation failure of one or more fields of a request. It is
+// returned to the caller as invalid argument with the field violations as
+// bad request details.
+type Error struct {
+	Violations []Violation
+}
+
+// NewError returns a validation error for a single field.
+func NewError(field string, description string) *Error {
+	return &Error{Violations: []Violation{{Field: field, Description: description}}}
+}
+
+func (e *Error) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+
+	for _, violation := range e.Violations {
+		messages = append(messages, fmt.Sprintf("%s: %s", violation.Field, violation.Description))
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// WithPrefix returns a copy of the error with every field prefixed, used when
+// the request was validated as part of a parent request.
+func (e *Error) WithPrefix(prefix string) *Error {
+	prefixed := &Error{Violations: make([]Violation, 0, len(e.Violations))}
+
+	for _, violation := range e.Violations {
+		prefixed.Violations = append(prefixed.Violations, Violation{
+			Field:       joinField(prefix, violation.Field),
+			Description: violation.Description,
+		})
+	}
+
+	return prefixed
+}
+
+// GRPCStatus returns the invalid argument status with the bad request details
+// of the validation error.
+func (e *Error) GRPCStatus() *status.Status {
+	badRequest := &errdetails.BadRequest{}
+
+	for _, violation := range e.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, e.Error())
+
+	if detailed, err := st.WithDetails(badRequest); err == nil {
+		return detailed
+	}
+
+	return st
+}
+
+// The error returned by messages generated by protoc-gen-validate.
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// The error returned by messages generated by protoc-gen-validate when all
+// fields are validated.
+type multiError interface {
+	AllErrors() []error
+}
+
+// FromError returns the validation error of the given error, which can be a
+// validation error, a protoc-gen-validate error or validator errors which are
+// translated with the translator. Returns false if the error is none of them.
+func FromError(err error, trans ut.Translator) (*Error, bool) {
+	var validationErr *Error
+
+	if errors.As(err, &validationErr) {
+		return validationErr, true
+	}
+
+	validationErrors := validator.ValidationErrors{}
+
+	if errors.As(err, &validationErrors) {
+		translated := TranslateError(err, trans)
+		validationErr = &Error{Violations: make([]Violation, 0, len(validationErrors))}
+
+		for i, e := range validationErrors {
+			validationErr.Violations = append(validationErr.Violations, Violation{
+				Field:       toFieldName(namespaceField(e.Namespace())),
+				Description: translated[i],
+			})
+		}
+
+		return validationErr, true
+	}
+
+	violations := protoViolations("", err)
+
+	if len(violations) == 0 {
+		return nil, false
+	}
+
+	return &Error{Violations: violations}, true
+}
+
+// protoViolations flattens the protoc-gen-validate error into the violations
+// of every field, including the fields of embedded messages.
+func protoViolations(prefix string, err error) []Violation {
+	if multi, ok := err.(multiError); ok {
+		var violations []Violation
+
+		for _, e := range multi.AllErrors() {
+			violations = append(violations, protoViolations(prefix, e)...)
+		}
+
+		return violations
+	}
+
+	e, ok := err.(fieldError)
+
+	if !ok {
+		return nil
+	}
+
+	field := joinField(prefix, toFieldName(e.Field()))
+
+	if e.Cause() != nil {
+		if nested := protoViolations(field, e.Cause()); len(nested) > 0 {
+			return nested
+		}
+	}
+
+	return []Violation{{Field: field, Description: e.Reason()}}
+}
+
+// namespaceField removes the name of the validated struct from the namespace
+// leaving only the path of the field.
+func namespaceField(namespace string) string {
+	if _, field, found := strings.Cut(namespace, "."); found {
+		return field
+	}
+
+	return namespace
+}
+
+func joinField(prefix string, field string) string {
+	if prefix == "" {
+		return field
+	}
+
+	return prefix + "." + field
+}
+
+// toFieldName converts the go field name into the snake case name of the
+// field used within the request, e.g. TestCases[0].StdinData becomes
+// test_cases[0].stdin_data.
+func toFieldName(name string) string {
+	var builder strings.Builder
+
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && name[i-1] != '.' && name[i-1] != ']' {
+				builder.WriteByte('_')
+			}
+
+			builder.WriteRune(unicode.ToLower(r))
+			continue
+		}
+
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,107 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	enTranslations "github.com/go-playground/validator/v10/translations/en"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	consumerv1 "compile-and-run-sandbox/internal/gen/pb/content/consumer/v1"
+)
+
+func getTranslator(t *testing.T, validate *validator.Validate) ut.Translator {
+	english := en.New()
+	translator, _ := ut.New(english, english).GetTranslator("en")
+
+	assert.NoError(t, enTranslations.RegisterDefaultTranslations(validate, translator))
+	return translator
+}
+
+func TestFromErrorProtoValidation(t *testing.T) {
+	request := &consumerv1.CreateCompileBatchRequest{
+		Requests: []*consumerv1.CreateCompileRequest{{
+			Language: "python",
+			Source:   "print('hello')",
+		}, {
+			Language: "unknown",
+			Source:   "1",
+		}},
+	}
+
+	validationErr, ok := FromError(request.ValidateAll(), nil)
+
+	assert.True(t, ok)
+	assert.NotEmpty(t, validationErr.Violations)
+
+	fields := make([]string, 0, len(validationErr.Violations))
+
+	for _, violation := range validationErr.Violations {
+		fields = append(fields, violation.Field)
+		assert.NotEmpty(t, violation.Description)
+	}
+
+	assert.Contains(t, fields, "requests[1].language")
+	assert.Contains(t, fields, "requests[1].source")
+}
+
+func TestFromErrorValidator(t *testing.T) {
+	type testCase struct {
+		StdinData string `validate:"required"`
+	}
+
+	type request struct {
+		TestCases []testCase `validate:"dive"`
+	}
+
+	validate := validator.New()
+	translator := getTranslator(t, validate)
+
+	err := validate.Struct(request{TestCases: []testCase{{StdinData: "data"}, {}}})
+	validationErr, ok := FromError(err, translator)
+
+	assert.True(t, ok)
+	assert.Equal(t, []Violation{{
+		Field:       "test_cases[1].stdin_data",
+		Description: "StdinData is a required field",
+	}}, validationErr.Violations)
+}
+
+func TestFromErrorUnknown(t *testing.T) {
+	_, ok := FromError(status.Error(codes.Internal, "internal"), nil)
+	assert.False(t, ok)
+}
+
+func TestErrorStatus(t *testing.T) {
+	err := NewError("time_limit_ms", "too large").WithPrefix("requests[2]")
+	st := status.Convert(err)
+
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "requests[2].time_limit_ms: too large", st.Message())
+	assert.Len(t, st.Details(), 1)
+
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+
+	assert.True(t, ok)
+	assert.Equal(t, "requests[2].time_limit_ms", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "too large", badRequest.FieldViolations[0].Description)
+}
+
+func TestToFieldName(t *testing.T) {
+	tests := map[string]string{
+		"Language":                   "language",
+		"TimeLimitMs":                "time_limit_ms",
+		"Requests[0]":                "requests[0]",
+		"TestCases[1].StdinData":     "test_cases[1].stdin_data",
+		"Requests[0].TestCases[1].A": "requests[0].test_cases[1].a",
+	}
+
+	for name, expected := range tests {
+		assert.Equal(t, expected, toFieldName(name), name)
+	}
+}
@@ -0,0 +1,387 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"compile-and-run-sandbox/internal/repository"
+)
+
+// SignatureHeader is the header containing the HMAC-SHA256 signature of the
+// request body, allowing the receiver to verify the payload came from cars.
+const SignatureHeader = "X-Cars-Signature"
+
+type Config struct {
+	// Secret is the key used to sign the payloads, receivers should use the
+	// same secret to verify the signature.
+	Secret string
+	// MaxAttempts is the maximum number of times a delivery is attempted
+	// before giving up.
+	MaxAttempts int
+	// InitialBackoff is the time waited before the first retry, doubling after
+	// every failed attempt.
+	InitialBackoff time.Duration
+	// Timeout is the maximum time a single delivery attempt can take.
+	Timeout time.Duration
+	// AllowPrivateNetworks allows delivering to loopback, link-local and
+	// private addresses, e.g. receivers running alongside cars locally. By
+	// default these are rejected, preventing callers from reaching internal
+	// services through the callback url.
+	AllowPrivateNetworks bool
+}
+
+// Attempt is the outcome of a single delivery attempt.
+type Attempt struct {
+	ExecutionID string
+	URL         string
+	Attempt     int
+	StatusCode  int
+	Error       string
+}
+
+// Recorder records every delivery attempt so they can be inspected later.
+type Recorder interface {
+	RecordWebhookAttempt(attempt *Attempt) error
+}
+
+// TestCase is the result of a single test case within the payload.
+type TestCase struct {
+	Status          string  `json:"status"`
+	TestStatus      string  `json:"test_status"`
+	RuntimeMs       int64   `json:"runtime_ms"`
+	RuntimeMemoryMb float64 `json:"runtime_memory_mb"`
+	Score           float64 `json:"score"`
+	CheckerMessage  string  `json:"checker_message,omitempty"`
+
+	InteractorRuntimeMs       int64   `json:"interactor_runtime_ms,omitempty"`
+	InteractorRuntimeMemoryMb float64 `json:"interactor_runtime_memory_mb,omitempty"`
+
+	Diff *TestCaseDiff `json:"diff,omitempty"`
+}
+
+// TestCaseDiff is the diff of the output of a failed test case and the
+// expected output.
+type TestCaseDiff struct {
+	Line          int    `json:"line,omitempty"`
+	Column        int    `json:"column,omitempty"`
+	Token         int    `json:"token,omitempty"`
+	Expected      string `json:"expected"`
+	Actual        string `json:"actual"`
+	ExpectedLines int    `json:"expected_lines"`
+	ActualLines   int    `json:"actual_lines"`
+	UnifiedDiff   string `json:"unified_diff,omitempty"`
+}
+
+// Payload is the body sent to the callback url once the execution completed.
+type Payload struct {
+	ID              string     `json:"id"`
+	Language        string     `json:"language"`
+	Status          string     `json:"status"`
+	TestStatus      string     `json:"test_status"`
+	CompileMs       int64      `json:"compile_ms"`
+	RuntimeMs       int64      `json:"runtime_ms"`
+	RuntimeMemoryMb float64    `json:"runtime_memory_mb"`
+	TestCases       []TestCase `json:"test_cases"`
+
+	Score      float64     `json:"score,omitempty"`
+	MaxScore   float64     `json:"max_score,omitempty"`
+	TestGroups []TestGroup `json:"test_groups,omitempty"`
+}
+
+// TestGroup is the result of a single group of tests within the payload.
+type TestGroup struct {
+	Name       string  `json:"name"`
+	TestStatus string  `json:"test_status"`
+	Score      float64 `json:"score"`
+	Weight     float64 `json:"weight"`
+}
+
+type Sender struct {
+	config   *Config
+	client   *http.Client
+	recorder Recorder
+
+	// ctx is the lifetime of the deliveries sent in the background, once
+	// done any remaining retries are abandoned.
+	ctx        context.Context
+	deliveries sync.WaitGroup
+}
+
+// NewSender returns a sender delivering the payloads in the background until
+// the context is done. Every connection is checked after resolving the host,
+// so a callback url resolving to a private address is rejected unless private
+// networks are allowed.
+func NewSender(ctx context.Context, config *Config, recorder Recorder) *Sender {
+	dialer := &net.Dialer{Timeout: config.Timeout}
+
+	if !config.AllowPrivateNetworks {
+		dialer.Control = controlPublicAddress
+	}
+
+	transport := &http.Transport{
+		// the connection to a proxy would bypass the address checks.
+		Proxy:               nil,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: config.Timeout,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     time.Minute,
+	}
+
+	return &Sender{
+		config:   config,
+		client:   &http.Client{Timeout: config.Timeout, Transport: transport},
+		recorder: recorder,
+		ctx:      ctx,
+	}
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the body using the
+// secret, in the same format as the signature header.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateURL ensures the callback url is an absolute http or https url. Unless
+// private networks are allowed, the host cannot be localhost or a loopback,
+// link-local or private address. Hosts resolving to these addresses are
+// rejected by the sender when delivering.
+func ValidateURL(callbackURL string, allowPrivateNetworks bool) error {
+	parsed, err := url.Parse(callbackURL)
+
+	if err != nil {
+		return errors.Wrap(err, "invalid callback url")
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("callback url must use http or https")
+	}
+
+	if parsed.Hostname() == "" {
+		return fmt.Errorf("callback url must contain a host")
+	}
+
+	if allowPrivateNetworks {
+		return nil
+	}
+
+	hostname := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+
+	if hostname == "localhost" || strings.HasSuffix(hostname, ".localhost") {
+		return fmt.Errorf("callback url must not be localhost")
+	}
+
+	if address, parseErr := netip.ParseAddr(hostname); parseErr == nil && !isPublicAddress(address) {
+		return fmt.Errorf("callback url must not be a loopback, link-local or private address")
+	}
+
+	return nil
+}
+
+// nonPublicPrefixes are the ranges which are not public, but not reported as
+// private by netip: "this network" and the carrier-grade NAT range.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// isPublicAddress returns true if the address is a public unicast address.
+func isPublicAddress(address netip.Addr) bool {
+	address = address.Unmap()
+
+	if !address.IsGlobalUnicast() || address.IsPrivate() {
+		return false
+	}
+
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(address) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// controlPublicAddress rejects connections to any address which is not a
+// public address, checked once the host has been resolved so a host resolving
+// to an internal address cannot be reached.
+func controlPublicAddress(_ string, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+
+	if err != nil {
+		return errors.Wrap(err, "invalid webhook address")
+	}
+
+	if !isPublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("webhook address %s is not a public address", addrPort.Addr())
+	}
+
+	return nil
+}
+
+// SendAsync delivers the payload in the background, bound by the lifetime of
+// the sender. Failing to deliver the payload is logged.
+func (s *Sender) SendAsync(callbackURL string, payload *Payload) {
+	s.deliveries.Add(1)
+
+	go func() {
+		defer s.deliveries.Done()
+
+		if err := s.Send(s.ctx, callbackURL, payload); err != nil {
+			log.Error().Err(err).Str("id", payload.ID).Msg("failed to deliver completion webhook")
+		}
+	}()
+}
+
+// Wait waits for the deliveries sent in the background to complete, returning
+// the context error if the context is done first.
+func (s *Sender) Wait(ctx context.Context) error {
+	done := make(chan any)
+
+	go func() {
+		defer close(done)
+		s.deliveries.Wait()
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Send delivers the signed payload to the callback url, retrying with an
+// exponential backoff on connection failures, server errors and rate limits.
+// Every attempt is recorded.
+func (s *Sender) Send(ctx context.Context, callbackURL string, payload *Payload) error {
+	body, err := json.Marshal(payload)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal webhook payload")
+	}
+
+	signature := Sign(s.config.Secret, body)
+	backoff := s.config.InitialBackoff
+
+	var lastErr error
+
+	for attempt := 1; attempt <= s.config.MaxAttempts; attempt++ {
+		statusCode, sendErr := s.deliver(ctx, callbackURL, body, signature)
+		s.record(payload.ID, callbackURL, attempt, statusCode, sendErr)
+
+		if sendErr == nil {
+			return nil
+		}
+
+		lastErr = sendErr
+
+		if !isRetryable(statusCode) || attempt == s.config.MaxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+	}
+
+	return errors.Wrapf(lastErr, "failed to deliver webhook to %s", callbackURL)
+}
+
+func (s *Sender) deliver(ctx context.Context, callbackURL string, body []byte, signature string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callbackURL, bytes.NewReader(body))
+
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create webhook request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, signature)
+
+	resp, err := s.client.Do(req)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to send webhook request")
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (s *Sender) record(executionID, callbackURL string, attempt, statusCode int, err error) {
+	if s.recorder == nil {
+		return
+	}
+
+	record := &Attempt{
+		ExecutionID: executionID,
+		URL:         callbackURL,
+		Attempt:     attempt,
+		StatusCode:  statusCode,
+	}
+
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	if recordErr := s.recorder.RecordWebhookAttempt(record); recordErr != nil {
+		log.Error().Err(recordErr).Str("id", executionID).Msg("failed to record webhook attempt")
+	}
+}
+
+// isRetryable returns true if the delivery should be retried based on the
+// status code, a zero status code is a connection failure.
+func isRetryable(statusCode int) bool {
+	return statusCode == 0 ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
+
+type repositoryRecorder struct {
+	repo repository.Repository
+}
+
+// NewRepositoryRecorder returns a recorder which stores the delivery attempts
+// within the repository.
+func NewRepositoryRecorder(repo repository.Repository) Recorder {
+	return repositoryRecorder{repo: repo}
+}
+
+func (r repositoryRecorder) RecordWebhookAttempt(attempt *Attempt) error {
+	return r.repo.InsertWebhookDelivery(&repository.WebhookDelivery{
+		ExecutionID: attempt.ExecutionID,
+		URL:         attempt.URL,
+		Attempt:     attempt.Attempt,
+		StatusCode:  attempt.StatusCode,
+		Error:       attempt.Error,
+	})
+}
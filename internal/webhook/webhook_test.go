@@ -0,0 +1,223 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryRecorder struct {
+	mu       sync.Mutex
+	attempts []*Attempt
+}
+
+func (m *memoryRecorder) RecordWebhookAttempt(attempt *Attempt) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.attempts = append(m.attempts, attempt)
+	return nil
+}
+
+func newTestSender(recorder Recorder) *Sender {
+	return NewSender(context.Background(), &Config{
+		Secret:         "secret",
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		Timeout:        time.Second,
+
+		// the test servers listen on the loopback address.
+		AllowPrivateNetworks: true,
+	}, recorder)
+}
+
+func TestSenderSignsPayload(t *testing.T) {
+	var body []byte
+	var signature string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		signature = r.Header.Get(SignatureHeader)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	recorder := &memoryRecorder{}
+	err := newTestSender(recorder).Send(context.Background(), server.URL, &Payload{ID: "id", Status: "Finished"})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id":"id","language":"","status":"Finished","test_status":"","compile_ms":0,
+		"runtime_ms":0,"runtime_memory_mb":0,"test_cases":null}`, string(body))
+	assert.Equal(t, Sign("secret", body), signature)
+
+	assert.Len(t, recorder.attempts, 1)
+	assert.Equal(t, http.StatusNoContent, recorder.attempts[0].StatusCode)
+	assert.Empty(t, recorder.attempts[0].Error)
+}
+
+func TestSenderRetries(t *testing.T) {
+	tests := []struct {
+		name          string
+		statusCodes   []int
+		expectErr     bool
+		expectedCalls int
+	}{
+		{
+			name:          "retries server errors until success",
+			statusCodes:   []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK},
+			expectedCalls: 3,
+		},
+		{
+			name:          "retries rate limits",
+			statusCodes:   []int{http.StatusTooManyRequests, http.StatusOK},
+			expectedCalls: 2,
+		},
+		{
+			name:          "gives up after the max attempts",
+			statusCodes:   []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			expectErr:     true,
+			expectedCalls: 3,
+		},
+		{
+			name:          "does not retry client errors",
+			statusCodes:   []int{http.StatusBadRequest},
+			expectErr:     true,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := atomic.AddInt32(&calls, 1)
+				w.WriteHeader(tt.statusCodes[call-1])
+			}))
+			defer server.Close()
+
+			recorder := &memoryRecorder{}
+			err := newTestSender(recorder).Send(context.Background(), server.URL, &Payload{ID: "id"})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expectedCalls, int(atomic.LoadInt32(&calls)))
+			assert.Len(t, recorder.attempts, tt.expectedCalls)
+
+			for i, attempt := range recorder.attempts {
+				assert.Equal(t, i+1, attempt.Attempt)
+				assert.Equal(t, tt.statusCodes[i], attempt.StatusCode)
+			}
+		})
+	}
+}
+
+func TestSenderRejectsPrivateAddresses(t *testing.T) {
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer server.Close()
+
+	sender := NewSender(context.Background(), &Config{MaxAttempts: 1, Timeout: time.Second}, &memoryRecorder{})
+	err := sender.Send(context.Background(), server.URL, &Payload{ID: "id"})
+
+	assert.ErrorContains(t, err, "is not a public address")
+	assert.Zero(t, atomic.LoadInt32(&calls))
+}
+
+func TestSenderSendAsync(t *testing.T) {
+	t.Run("should wait for the deliveries to complete", func(t *testing.T) {
+		var calls int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		}))
+		defer server.Close()
+
+		sender := newTestSender(&memoryRecorder{})
+		sender.SendAsync(server.URL, &Payload{ID: "id"})
+
+		assert.NoError(t, sender.Wait(context.Background()))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("should abandon the retries once the sender is done", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		sender := NewSender(ctx, &Config{
+			MaxAttempts:          5,
+			InitialBackoff:       time.Hour,
+			Timeout:              time.Second,
+			AllowPrivateNetworks: true,
+		}, &memoryRecorder{})
+
+		sender.SendAsync(server.URL, &Payload{ID: "id"})
+
+		waitCtx, cancelWait := context.WithTimeout(context.Background(), time.Millisecond*50)
+		defer cancelWait()
+
+		assert.ErrorIs(t, sender.Wait(waitCtx), context.DeadlineExceeded)
+
+		cancel()
+
+		assert.NoError(t, sender.Wait(context.Background()))
+	})
+}
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		allowed bool
+		wantErr bool
+	}{
+		{url: "https://example.com/hook"},
+		{url: "http://93.184.216.34:8080/hook"},
+		{url: "ftp://example.com/hook", wantErr: true},
+		{url: "https:///hook", wantErr: true},
+		{url: "not a url", wantErr: true},
+		{url: "http://localhost:8080/hook", wantErr: true},
+		{url: "http://api.localhost/hook", wantErr: true},
+		{url: "http://127.0.0.1/hook", wantErr: true},
+		{url: "http://[::1]/hook", wantErr: true},
+		{url: "http://169.254.169.254/latest/meta-data", wantErr: true},
+		{url: "http://10.0.0.1/hook", wantErr: true},
+		{url: "http://192.168.1.1/hook", wantErr: true},
+		{url: "http://100.64.0.1/hook", wantErr: true},
+		{url: "http://0.0.0.0/hook", wantErr: true},
+		{url: "http://[::ffff:127.0.0.1]/hook", wantErr: true},
+		{url: "http://[fd00::1]/hook", wantErr: true},
+		{url: "http://localhost:8080/hook", allowed: true},
+		{url: "http://10.0.0.1/hook", allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := ValidateURL(tt.url, tt.allowed)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}